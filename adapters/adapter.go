@@ -12,6 +12,8 @@ import (
 var (
 	// TaskTypeCopy is the identifier for the Copy adapter.
 	TaskTypeCopy = models.MustNewTaskType("copy")
+	// TaskTypeDeviation is the identifier for the Deviation adapter.
+	TaskTypeDeviation = models.MustNewTaskType("deviation")
 	// TaskTypeEthBool is the identifier for the EthBool adapter.
 	TaskTypeEthBool = models.MustNewTaskType("ethbool")
 	// TaskTypeEthBytes32 is the identifier for the EthBytes32 adapter.
@@ -74,6 +76,9 @@ func For(task models.TaskSpec, store *store.Store) (*PipelineAdapter, error) {
 	case TaskTypeCopy:
 		ba = &Copy{}
 		err = unmarshalParams(task.Params, ba)
+	case TaskTypeDeviation:
+		ba = &Deviation{}
+		err = unmarshalParams(task.Params, ba)
 	case TaskTypeEthBool:
 		ba = &EthBool{}
 		err = unmarshalParams(task.Params, ba)
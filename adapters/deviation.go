@@ -0,0 +1,75 @@
+package adapters
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+)
+
+// Deviation holds the percentage by which a value must move, and the time
+// after which it is submitted regardless, for the tasks after it to run.
+type Deviation struct {
+	Threshold float64         `json:"threshold"`
+	Heartbeat models.Duration `json:"heartbeat"`
+}
+
+// Perform compares the input's "value" field to the value the job last
+// submitted, or is submitting. When it deviates by at least the Threshold
+// percentage, when the Heartbeat has elapsed since that value, or when there
+// is no such value, the input is recorded as in flight and passed on to the
+// remaining tasks. Otherwise the run completes without running them.
+//
+// For example, with a "threshold" of 0.5 and a last submitted value of
+// "200", an input value of "201" proceeds while "200.9" is skipped.
+func (d *Deviation) Perform(input models.RunResult, store *store.Store) models.RunResult {
+	val := input.Get("value")
+	value, ok := new(big.Float).SetString(val.String())
+	if !ok {
+		return input.WithError(fmt.Errorf("cannot parse into big.Float: %v", val.String()))
+	}
+
+	run, err := store.FindJobRun(input.JobRunID)
+	if err != nil {
+		return input.WithError(err)
+	}
+	ds, err := store.FindDeviationSubmission(run.JobID)
+	if err == orm.ErrorNotFound {
+		ds = models.DeviationSubmission{JobID: run.JobID}
+	} else if err != nil {
+		return input.WithError(err)
+	}
+
+	if skip, err := d.skip(ds, value, store); err != nil {
+		return input.WithError(err)
+	} else if skip {
+		return input.MarkSkipped()
+	}
+
+	ds.StartInFlight(run, val.String(), store.Clock.Now())
+	if err := store.SaveDeviationSubmission(&ds); err != nil {
+		return input.WithError(err)
+	}
+	input.Status = models.RunStatusCompleted
+	return input
+}
+
+func (d *Deviation) skip(ds models.DeviationSubmission, value *big.Float, store *store.Store) (bool, error) {
+	_, at, ok := ds.Latest()
+	if !ok {
+		return false, nil
+	}
+
+	heartbeat := d.Heartbeat.Duration()
+	if heartbeat > 0 && store.Clock.Now().Sub(at) >= heartbeat {
+		return false, nil
+	}
+
+	deviation, err := ds.Deviation(value)
+	if err != nil {
+		return false, err
+	}
+	return deviation.Cmp(big.NewFloat(d.Threshold)) < 0, nil
+}
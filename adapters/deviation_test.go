@@ -0,0 +1,88 @@
+package adapters_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/adapters"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviation_Perform(t *testing.T) {
+	t.Parallel()
+
+	now := cltest.ParseISO8601("2019-01-01T12:00:00.000Z")
+	tests := []struct {
+		name        string
+		params      string
+		last        string
+		inFlight    string
+		submittedAt time.Time
+		value       string
+		wantSkipped bool
+		errored     bool
+	}{
+		{"no submission", `{"threshold":1}`, "", "", now, "100", false, false},
+		{"below threshold", `{"threshold":1}`, "100", "", now, "100.5", true, false},
+		{"at threshold", `{"threshold":1}`, "100", "", now, "99", false, false},
+		{"above threshold", `{"threshold":1}`, "100", "", now, "102", false, false},
+		{"heartbeat not elapsed", `{"threshold":1,"heartbeat":"1h"}`, "100", "", now.Add(-time.Minute), "100", true, false},
+		{"heartbeat elapsed", `{"threshold":1,"heartbeat":"1h"}`, "100", "", now.Add(-time.Hour), "100", false, false},
+		{"from zero", `{"threshold":1}`, "0", "", now, "0.001", false, false},
+		{"non numeric value", `{"threshold":1}`, "100", "", now, "abc", false, true},
+		{"near value in flight", `{"threshold":1}`, "100", "102", now, "102.5", true, false},
+		{"far from value in flight", `{"threshold":1}`, "100", "102", now, "100", false, false},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			store, cleanup := cltest.NewStore()
+			defer cleanup()
+			cltest.UseSettableClock(store).SetTime(now)
+
+			j, initr := cltest.NewJobWithWebInitiator()
+			require.NoError(t, store.SaveJob(&j))
+			run := j.NewRun(initr)
+			require.NoError(t, store.SaveJobRun(&run))
+			if test.last != "" {
+				ds := models.NewDeviationSubmission(run, test.last, test.submittedAt)
+				if test.inFlight != "" {
+					ds.StartInFlight(models.JobRun{ID: "pending", JobID: j.ID}, test.inFlight, test.submittedAt)
+				}
+				require.NoError(t, store.SaveDeviationSubmission(&ds))
+			}
+
+			adapter := adapters.Deviation{}
+			require.NoError(t, json.Unmarshal([]byte(test.params), &adapter))
+			input := models.RunResult{
+				JobRunID: run.ID,
+				Data:     cltest.JSONFromString(`{"value":"` + test.value + `"}`),
+			}
+			result := adapter.Perform(input, store)
+
+			if test.errored {
+				assert.Error(t, result.GetError())
+				return
+			}
+			assert.NoError(t, result.GetError())
+			assert.Equal(t, models.RunStatusCompleted, result.Status)
+			assert.Equal(t, test.wantSkipped, result.Skipped)
+			assert.Equal(t, test.value, result.Get("value").String())
+
+			ds, err := store.FindDeviationSubmission(j.ID)
+			require.NoError(t, err)
+			if test.wantSkipped {
+				assert.NotEqual(t, run.ID, ds.InFlightRunID)
+				return
+			}
+			assert.Equal(t, run.ID, ds.InFlightRunID)
+			assert.Equal(t, test.value, ds.InFlightValue)
+			assert.Equal(t, now.Unix(), ds.InFlightAt.Unix())
+		})
+	}
+}
@@ -32,13 +32,17 @@ type EthTx struct {
 
 // Perform creates the run result for the transaction if the existing run result
 // is not currently pending. Then it confirms the transaction was confirmed on
-// the blockchain.
+// the blockchain. When configured to, new transactions are held while any of
+// the node's accounts is low on ETH.
 func (etx *EthTx) Perform(input models.RunResult, store *store.Store) models.RunResult {
 	if !store.TxManager.Connected() {
 		return input.MarkPendingConnection()
 	}
 
 	if !input.Status.PendingConfirmations() {
+		if store.Config.EthTxHoldOnLowBalance() && !store.Balances.Funded() {
+			return input.MarkPendingFunds()
+		}
 		return createTxRunResult(etx, input, store)
 	}
 	return ensureTxRunResult(input, store)
@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/internal/mocks"
 	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
@@ -538,3 +539,41 @@ func TestEthTxAdapter_Perform_NotConnected(t *testing.T) {
 	assert.False(t, data.HasError())
 	assert.Equal(t, models.RunStatusPendingConnection, data.Status)
 }
+
+func TestEthTxAdapter_Perform_HoldsOnLowBalance(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("ETH_TX_HOLD_ON_LOW_BALANCE", true)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	txmMock := mocks.NewMockTxManager(ctrl)
+	store.TxManager = txmMock
+	txmMock.EXPECT().Connected().Return(true).AnyTimes()
+
+	address := cltest.NewAddress()
+	store.Balances.Update(address, assets.NewEth(0), store.Config.EthBalanceThreshold())
+
+	adapter := adapters.EthTx{
+		Address:          cltest.NewAddress(),
+		FunctionSelector: models.HexToFunctionSelector("0xb3f98adc"),
+	}
+	input := models.RunResult{
+		Data:   cltest.JSONFromString(`{"value": "hello world"}`),
+		Status: models.RunStatusInProgress,
+	}
+
+	result := adapter.Perform(input, store)
+	assert.False(t, result.HasError())
+	assert.Equal(t, models.RunStatusPendingFunds, result.Status)
+
+	store.Balances.Update(address, store.Config.EthBalanceThreshold(), store.Config.EthBalanceThreshold())
+	txmMock.EXPECT().CreateTxWithGas(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&models.Tx{}, nil)
+	txmMock.EXPECT().BumpGasUntilSafe(gomock.Any())
+
+	result = adapter.Perform(result, store)
+	assert.False(t, result.HasError())
+	assert.Equal(t, models.RunStatusPendingConfirmations, result.Status)
+}
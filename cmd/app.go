@@ -78,6 +78,27 @@ func NewApp(client *Client) *cli.App {
 					Name:  "page",
 					Usage: "page of results to display",
 				},
+				cli.StringFlag{
+					Name:  "name",
+					Usage: "only display the job with this name",
+				},
+				cli.StringFlag{
+					Name:  "tag",
+					Usage: "only display jobs with all of these comma separated tags",
+				},
+			},
+			Subcommands: []cli.Command{
+				{
+					Name:   "sync",
+					Usage:  "Create, archive and report drift of named jobs to match a directory of job spec files",
+					Action: client.SyncJobSpecs,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "dry-run",
+							Usage: "report what would change without creating or archiving jobs",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -92,12 +113,113 @@ func NewApp(client *Client) *cli.App {
 			Usage:   "Create job spec from JSON",
 			Action:  client.CreateJobSpec,
 		},
+		{
+			Name:   "update",
+			Usage:  "Update a job spec by ID from JSON, saving it as the job's next version",
+			Action: client.UpdateJobSpec,
+		},
+		{
+			Name:   "versions",
+			Usage:  "List every version of a job spec",
+			Action: client.GetJobSpecVersions,
+		},
+		{
+			Name:   "diff",
+			Usage:  "Show the changes made to a job spec up to the given version",
+			Action: client.DiffJobSpec,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "from",
+					Usage: "version to compare with, defaults to the preceding version",
+				},
+			},
+		},
 		{
 			Name:    "run",
 			Aliases: []string{"r"},
 			Usage:   "Begin job run for specid",
 			Action:  client.CreateJobRun,
 		},
+		{
+			Name:   "runs",
+			Usage:  "List job runs, optionally filtered",
+			Action: client.GetJobRuns,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+				cli.StringFlag{
+					Name:  "job",
+					Usage: "only show runs of the job spec with this ID",
+				},
+				cli.StringSliceFlag{
+					Name:  "status",
+					Usage: "only show runs with this status, can be repeated",
+				},
+				cli.StringSliceFlag{
+					Name:  "initiator",
+					Usage: "only show runs started by this initiator type, can be repeated",
+				},
+				cli.StringSliceFlag{
+					Name:  "task",
+					Usage: "only show runs containing a task of this type, can be repeated",
+				},
+				cli.StringFlag{
+					Name:  "requester",
+					Usage: "only show runs requested on chain by this address",
+				},
+				cli.StringFlag{
+					Name:  "created-after",
+					Usage: "only show runs created at or after this RFC3339 time",
+				},
+				cli.StringFlag{
+					Name:  "created-before",
+					Usage: "only show runs created before this RFC3339 time",
+				},
+				cli.StringFlag{
+					Name:  "completed-after",
+					Usage: "only show runs completed at or after this RFC3339 time",
+				},
+				cli.StringFlag{
+					Name:  "completed-before",
+					Usage: "only show runs completed before this RFC3339 time",
+				},
+				cli.StringFlag{
+					Name:  "sort",
+					Usage: "createdAt or updatedAt, prefixed with - for descending order",
+				},
+			},
+		},
+		{
+			Name:   "earnings",
+			Usage:  "Report LINK earned against gas spent for a job, or for the whole node if no job ID is given",
+			Action: client.ShowEarnings,
+		},
+		{
+			Name:   "signingaccount",
+			Usage:  "Show the account service agreements are signed with, or rotate to the account with the given <address>",
+			Action: client.SigningAccount,
+		},
+		{
+			Name:   "exportruns",
+			Usage:  "Export completed job runs with their payments and gas costs to a file",
+			Action: client.ExportJobRuns,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "csv or ndjson, defaults to csv",
+				},
+				cli.StringFlag{
+					Name:  "from",
+					Usage: "only export runs completed at or after this RFC3339 time",
+				},
+				cli.StringFlag{
+					Name:  "to",
+					Usage: "only export runs completed before this RFC3339 time",
+				},
+			},
+		},
 		{
 			Name:    "showrun",
 			Aliases: []string{"sr"},
@@ -109,6 +231,69 @@ func NewApp(client *Client) *cli.App {
 			Usage:  "Backup the database of the running node",
 			Action: client.BackupDatabase,
 		},
+		{
+			Name:   "restore",
+			Usage:  "Replace the *local node's* database with a backup, after verifying and migrating it. Stop the node first.",
+			Action: client.RestoreDatabase,
+		},
+		{
+			Name:   "compact",
+			Usage:  "Compact the database of the running node the next time it starts, shrinking the file to the records it holds. Takes effect only after the node is restarted",
+			Action: client.CompactDatabase,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "cancel",
+					Usage: "withdraw a pending compaction request",
+				},
+			},
+		},
+		{
+			Name:   "bulkdeleteruns",
+			Usage:  "Delete the runs with one of the given statuses that match the other filters, in the background",
+			Action: client.CreateBulkDeleteRuns,
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  "status",
+					Usage: "delete runs with this status, completed or errored, can be repeated",
+				},
+				cli.StringSliceFlag{
+					Name:  "job",
+					Usage: "only delete runs of the job spec with this ID, can be repeated",
+				},
+				cli.StringSliceFlag{
+					Name:  "initiator",
+					Usage: "only delete runs started by this initiator type, can be repeated",
+				},
+				cli.StringFlag{
+					Name:  "updated-before",
+					Usage: "only delete runs last updated before this RFC3339 time",
+				},
+				cli.StringFlag{
+					Name:  "completed-before",
+					Usage: "only delete runs completed before this RFC3339 time",
+				},
+				cli.BoolFlag{
+					Name:  "watch",
+					Usage: "show the progress of the deletion until it finishes",
+				},
+			},
+		},
+		{
+			Name:   "showbulkdelete",
+			Usage:  "Show the progress of a bulk delete of runs",
+			Action: client.ShowBulkDeleteRuns,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "watch",
+					Usage: "keep showing the progress until the deletion finishes",
+				},
+			},
+		},
+		{
+			Name:   "cancelbulkdelete",
+			Usage:  "Stop a bulk delete of runs, keeping the runs not yet deleted",
+			Action: client.CancelBulkDeleteRuns,
+		},
 		{
 			Name:    "import",
 			Aliases: []string{"i"},
@@ -147,6 +332,48 @@ func NewApp(client *Client) *cli.App {
 			Usage:   "Creates a service agreement",
 			Action:  client.CreateServiceAgreement,
 		},
+		{
+			Name:   "agreements",
+			Usage:  "List the service agreements signed by the node",
+			Action: client.GetServiceAgreements,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
+		{
+			Name:   "showagreement",
+			Usage:  "Show a specific service agreement",
+			Action: client.ShowServiceAgreement,
+		},
+		{
+			Name:   "verifyagreement",
+			Usage:  "Check that --signature over the service agreement in <JSON or filepath> was made by one of its oracles",
+			Action: client.VerifyServiceAgreement,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "signature",
+					Usage: "hex encoded signature to verify",
+				},
+				cli.StringFlag{
+					Name:  "address",
+					Usage: "only accept a signature made by this oracle address",
+				},
+			},
+		},
+		{
+			Name:   "coordinate",
+			Usage:  "Collect signatures for the service agreement in <JSON or filepath> from each --node, for initiating it on the Coordinator contract",
+			Action: client.CoordinateServiceAgreement,
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  "node",
+					Usage: "URL of a participating node to request a signature from, can be repeated",
+				},
+			},
+		},
 		{
 			Name:    "withdraw",
 			Aliases: []string{"w"},
@@ -107,6 +107,7 @@ type HTTPClient interface {
 	Post(string, io.Reader) (*http.Response, error)
 	Patch(string, io.Reader, ...map[string]string) (*http.Response, error)
 	Delete(string) (*http.Response, error)
+	DeleteWithBody(string, io.Reader) (*http.Response, error)
 }
 
 type authenticatedHTTPClient struct {
@@ -145,6 +146,12 @@ func (h *authenticatedHTTPClient) Delete(path string) (*http.Response, error) {
 	return h.doRequest("DELETE", path, nil)
 }
 
+// DeleteWithBody performs an HTTP Delete with a request body using the
+// authenticated HTTP client's cookie.
+func (h *authenticatedHTTPClient) DeleteWithBody(path string, body io.Reader) (*http.Response, error) {
+	return h.doRequest("DELETE", path, body)
+}
+
 func (h *authenticatedHTTPClient) doRequest(verb, path string, body io.Reader, headerArgs ...map[string]string) (*http.Response, error) {
 	cookie, err := h.cookieAuth.Cookie()
 	if err != nil {
@@ -30,12 +30,11 @@ func (cli *Client) RunNode(c *clipkg.Context) error {
 
 	app := cli.AppFactory.NewApplication(config)
 	store := app.GetStore()
-	pwd, err := passwordFromFile(c.String("password"))
-	if err != nil {
+	if url := config.SignerURL(); url != "" {
+		logger.Info("Signing with remote signer at ", url)
+	} else if pwd, err := passwordFromFile(c.String("password")); err != nil {
 		return cli.errorOut(fmt.Errorf("error reading password: %+v", err))
-	}
-	_, err = cli.KeyStoreAuthenticator.Authenticate(store, pwd)
-	if err != nil {
+	} else if _, err = cli.KeyStoreAuthenticator.Authenticate(store, pwd); err != nil {
 		return cli.errorOut(fmt.Errorf("error authenticating keystore: %+v", err))
 	}
 
@@ -146,6 +145,17 @@ func (cli *Client) DeleteUser(c *clipkg.Context) error {
 	return err
 }
 
+// RestoreDatabase replaces the *local node's* database with the backup at the
+// given path, after checking it and running any pending migrations on it. The
+// node must be stopped first.
+func (cli *Client) RestoreDatabase(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the path of the backup to restore"))
+	}
+	logger.SetLogger(cli.Config.CreateProductionLogger())
+	return cli.errorOut(strpkg.RestoreDatabase(cli.Config, c.Args().First()))
+}
+
 // ImportKey imports a key to be used with the chainlink node
 func (cli *Client) ImportKey(c *clipkg.Context) error {
 	cfg := cli.Config
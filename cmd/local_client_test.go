@@ -3,12 +3,15 @@ package cmd_test
 import (
 	"flag"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/cmd"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/orm"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
@@ -58,6 +61,7 @@ func TestClient_RunNodeShowsEnv(t *testing.T) {
 	assert.Contains(t, logs, "ETH_GAS_BUMP_WEI: 5000000000\\n")
 	assert.Contains(t, logs, "ETH_GAS_PRICE_DEFAULT: 20000000000\\n")
 	assert.Contains(t, logs, "LINK_CONTRACT_ADDRESS: 0x514910771AF9Ca656af840dff83E8264EcF986CA\\n")
+	assert.Contains(t, logs, "WEBHOOK_MAX_ATTEMPTS: 5\\n")
 	assert.Contains(t, logs, "MINIMUM_CONTRACT_PAYMENT: 0.000000000000000100\\n")
 	assert.Contains(t, logs, "ORACLE_CONTRACT_ADDRESS: \\n")
 	assert.Contains(t, logs, "DATABASE_TIMEOUT: 500ms\\n")
@@ -179,6 +183,41 @@ func TestClient_ImportKey(t *testing.T) {
 	assert.Error(t, client.ImportKey(c))
 }
 
+func TestClient_RestoreDatabase(t *testing.T) {
+	t.Parallel()
+
+	source, cleanup := cltest.NewStore()
+	defer cleanup()
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, source.SaveJob(&job))
+	backup := filepath.Join(source.Config.RootDir(), "backup.bolt")
+	file, err := os.Create(backup)
+	require.NoError(t, err)
+	_, err = source.WriteBackup(file)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	config, cleanup := cltest.NewConfig()
+	defer cleanup()
+	defer os.RemoveAll(config.RootDir())
+	client := &cmd.Client{Config: config.Config}
+
+	set := flag.NewFlagSet("restore", 0)
+	c := cli.NewContext(nil, set, nil)
+	assert.Error(t, client.RestoreDatabase(c))
+
+	set = flag.NewFlagSet("restore", 0)
+	set.Parse([]string{backup})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.RestoreDatabase(c))
+
+	restored, err := orm.NewORM(config.DatabasePath(), time.Second)
+	require.NoError(t, err)
+	defer restored.Close()
+	_, err = restored.FindJob(job.ID)
+	assert.NoError(t, err)
+}
+
 func TestClient_LogToDiskOptionDisablesAsExpected(t *testing.T) {
 	t.Parallel()
 
@@ -10,7 +10,11 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/store/assets"
@@ -28,6 +32,10 @@ import (
 
 var errUnauthorized = errors.New("401 Unauthorized")
 
+// bulkDeleteWatchInterval is how often a watched bulk delete task is checked
+// for progress.
+var bulkDeleteWatchInterval = time.Second
+
 // DisplayAccountBalance renders a table containing the active account address
 // with it's ETH & LINK balance
 func (cli *Client) DisplayAccountBalance(c *clipkg.Context) error {
@@ -66,6 +74,105 @@ func (cli *Client) CreateServiceAgreement(c *clipkg.Context) error {
 	return cli.renderResponse(resp, &sa)
 }
 
+// GetServiceAgreements lists the service agreements signed by the node.
+func (cli *Client) GetServiceAgreements(c *clipkg.Context) error {
+	var links jsonapi.Links
+	var sas []presenters.ServiceAgreement
+	err := cli.getPage("/v2/service_agreements", c.Int("page"), &sas, &links)
+	if err != nil {
+		return err
+	}
+	return cli.errorOut(cli.Render(&sas))
+}
+
+// ShowServiceAgreement returns the details of the given ServiceAgreement.
+func (cli *Client) ShowServiceAgreement(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the service agreement id to be shown"))
+	}
+	resp, err := cli.HTTP.Get("/v2/service_agreements/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var sa presenters.ServiceAgreement
+	return cli.renderAPIResponse(resp, &sa)
+}
+
+// CoordinateServiceAgreement has the node collect the signatures of every
+// participating node for the agreement in the JSON input.
+func (cli *Client) CoordinateServiceAgreement(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass in JSON or filepath"))
+	}
+	if len(c.StringSlice("node")) == 0 {
+		return cli.errorOut(errors.New("Must pass at least one --node"))
+	}
+
+	buf, err := getBufferFromJSON(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	request := models.CoordinateServiceAgreementRequest{Agreement: buf.Bytes()}
+	for _, node := range c.StringSlice("node") {
+		u, err := url.ParseRequestURI(node)
+		if err != nil {
+			return cli.errorOut(fmt.Errorf("Invalid node URL '%s': %v", node, err))
+		}
+		request.Nodes = append(request.Nodes, models.WebURL(*u))
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/service_agreements/coordinate", bytes.NewBuffer(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var csa models.CoordinatorServiceAgreement
+	return cli.renderAPIResponse(resp, &csa)
+}
+
+// VerifyServiceAgreement checks the signature over the agreement in the JSON
+// input against the agreement's oracles.
+func (cli *Client) VerifyServiceAgreement(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass in JSON or filepath"))
+	}
+	if c.String("signature") == "" {
+		return cli.errorOut(errors.New("Must pass the --signature to verify"))
+	}
+
+	buf, err := getBufferFromJSON(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	request := models.VerifyServiceAgreementRequest{Agreement: buf.Bytes()}
+	if err := request.Signature.UnmarshalText([]byte(c.String("signature"))); err != nil {
+		return cli.errorOut(fmt.Errorf("Invalid signature: %v", err))
+	}
+	if c.String("address") != "" {
+		if !common.IsHexAddress(c.String("address")) {
+			return cli.errorOut(fmt.Errorf("Invalid address '%s'", c.String("address")))
+		}
+		request.Address = common.HexToAddress(c.String("address"))
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/service_agreements/verify", bytes.NewBuffer(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var sav models.ServiceAgreementVerification
+	return cli.renderAPIResponse(resp, &sav)
+}
+
 // ShowJobRun returns the status of the given Jobrun.
 func (cli *Client) ShowJobRun(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -80,6 +187,42 @@ func (cli *Client) ShowJobRun(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &job)
 }
 
+// GetJobRuns returns job runs, optionally filtered by job, status, initiator
+// type, task type, requester and time range.
+func (cli *Client) GetJobRuns(c *clipkg.Context) error {
+	params := url.Values{}
+	for flag, param := range map[string]string{
+		"job":              "jobSpecId",
+		"requester":        "requester",
+		"created-after":    "createdAfter",
+		"created-before":   "createdBefore",
+		"completed-after":  "completedAfter",
+		"completed-before": "completedBefore",
+		"sort":             "sort",
+	} {
+		if val := c.String(flag); val != "" {
+			params.Set(param, val)
+		}
+	}
+	for flag, param := range map[string]string{
+		"status":    "status",
+		"initiator": "initiatorType",
+		"task":      "taskType",
+	} {
+		for _, val := range c.StringSlice(flag) {
+			params.Add(param, val)
+		}
+	}
+
+	var links jsonapi.Links
+	runs := []presenters.JobRun{}
+	err := cli.getPage("/v2/runs?"+params.Encode(), c.Int("page"), &runs, &links)
+	if err != nil {
+		return err
+	}
+	return cli.errorOut(cli.Render(&runs))
+}
+
 // ShowJobSpec returns the status of the given JobID.
 func (cli *Client) ShowJobSpec(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -94,11 +237,19 @@ func (cli *Client) ShowJobSpec(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &job)
 }
 
-// GetJobSpecs returns all job specs.
+// GetJobSpecs returns all job specs, optionally filtered by name and tags.
 func (cli *Client) GetJobSpecs(c *clipkg.Context) error {
+	query := url.Values{}
+	if name := c.String("name"); name != "" {
+		query.Set("name", name)
+	}
+	if tags := c.String("tag"); tags != "" {
+		query.Set("tag", tags)
+	}
+
 	var links jsonapi.Links
 	var jobs []models.JobSpec
-	err := cli.getPage("/v2/specs", c.Int("page"), &jobs, &links)
+	err := cli.getPage("/v2/specs?"+query.Encode(), c.Int("page"), &jobs, &links)
 	if err != nil {
 		return err
 	}
@@ -126,6 +277,253 @@ func (cli *Client) CreateJobSpec(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &js)
 }
 
+// UpdateJobSpec saves the fields in the JSON passed, or the file at its
+// path, as the next version of a job.
+func (cli *Client) UpdateJobSpec(c *clipkg.Context) error {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("Must pass the job id and JSON or filepath"))
+	}
+
+	buf, err := getBufferFromJSON(c.Args().Get(1))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/specs/"+c.Args().First(), buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var js presenters.JobSpec
+	return cli.renderAPIResponse(resp, &js)
+}
+
+// GetJobSpecVersions lists every version of a job, oldest first.
+func (cli *Client) GetJobSpecVersions(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the job id"))
+	}
+	resp, err := cli.HTTP.Get("/v2/specs/" + c.Args().First() + "/versions")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var versions []models.JobSpecVersion
+	return cli.renderAPIResponse(resp, &versions)
+}
+
+// DiffJobSpec shows the changes made to a job up to the given version, from
+// the version passed with --from, or the one preceding it.
+func (cli *Client) DiffJobSpec(c *clipkg.Context) error {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("Must pass the job id and version"))
+	}
+	uri := fmt.Sprintf("/v2/specs/%s/versions/%s/diff", c.Args().First(), c.Args().Get(1))
+	if from := c.String("from"); from != "" {
+		uri += "?" + url.Values{"from": []string{from}}.Encode()
+	}
+
+	resp, err := cli.HTTP.Get(uri)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var diff presenters.JobSpecDiff
+	if err := cli.deserializeResponse(resp, &diff); err != nil {
+		return err
+	}
+	return cli.errorOut(cli.Render(&diff))
+}
+
+// Actions taken by SyncJobSpecs for each job.
+const (
+	jobSyncCreate    = "create"
+	jobSyncArchive   = "archive"
+	jobSyncUnchanged = "unchanged"
+	jobSyncDrifted   = "drifted"
+)
+
+// SyncJobSpecs reconciles the named jobs on the node with a directory of job
+// spec files, keyed by job name. Jobs without a match on the node are
+// created, named jobs without a file are archived, and jobs that differ from
+// their file are reported as drifted.
+func (cli *Client) SyncJobSpecs(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the directory of job spec files"))
+	}
+	specs, err := readJobSpecDir(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	jobs, err := cli.activeNamedJobSpecs()
+	if err != nil {
+		return err
+	}
+
+	dryRun := c.Bool("dry-run")
+	results := []presenters.JobSyncResult{}
+	for _, spec := range specs {
+		result := presenters.JobSyncResult{Name: spec.name}
+		if job, ok := jobs[spec.name]; ok {
+			delete(jobs, spec.name)
+			result.JobID = job.ID
+			if result.Drift, err = cli.jobSpecDrift(job.ID, spec.export); err != nil {
+				return err
+			} else if len(result.Drift) > 0 {
+				result.Action = jobSyncDrifted
+			} else {
+				result.Action = jobSyncUnchanged
+			}
+		} else {
+			result.Action = jobSyncCreate
+			if !dryRun {
+				if result.JobID, err = cli.createJobSpec(spec.raw); err != nil {
+					return cli.errorOut(fmt.Errorf("Error creating job %s: %v", spec.name, err))
+				}
+			}
+		}
+		results = append(results, result)
+	}
+
+	names := make([]string, 0, len(jobs))
+	for name := range jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		id := jobs[name].ID
+		if !dryRun {
+			if err := cli.archiveJobSpec(id); err != nil {
+				return cli.errorOut(fmt.Errorf("Error archiving job %s: %v", name, err))
+			}
+		}
+		results = append(results, presenters.JobSyncResult{Name: name, JobID: id, Action: jobSyncArchive})
+	}
+	return cli.errorOut(cli.Render(&results))
+}
+
+type jobSpecFile struct {
+	name   string
+	raw    []byte
+	export map[string]interface{}
+}
+
+// readJobSpecDir reads the named job specs from the JSON files in dir.
+func readJobSpecDir(dir string) ([]jobSpecFile, error) {
+	dir, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := []jobSpecFile{}
+	seen := map[string]string{}
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+		raw, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var js models.JobSpec
+		if err := json.Unmarshal(raw, &js); err != nil {
+			return nil, fmt.Errorf("Error parsing %s: %v", f.Name(), err)
+		} else if js.Name == "" {
+			return nil, fmt.Errorf("%s has no job name", f.Name())
+		} else if other, ok := seen[js.Name]; ok {
+			return nil, fmt.Errorf("%s and %s both define job %s", other, f.Name(), js.Name)
+		}
+		seen[js.Name] = f.Name()
+
+		b, err := json.Marshal(presenters.NewJobSpecExport(js))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing %s: %v", f.Name(), err)
+		}
+		var export map[string]interface{}
+		if err := json.Unmarshal(b, &export); err != nil {
+			return nil, err
+		}
+		specs = append(specs, jobSpecFile{name: js.Name, raw: raw, export: export})
+	}
+	return specs, nil
+}
+
+func (cli *Client) activeNamedJobSpecs() (map[string]models.JobSpec, error) {
+	jobs := map[string]models.JobSpec{}
+	for page := 1; ; page++ {
+		var links jsonapi.Links
+		var pageJobs []models.JobSpec
+		if err := cli.getPage("/v2/specs", page, &pageJobs, &links); err != nil {
+			return nil, err
+		}
+		for _, j := range pageJobs {
+			if j.Name != "" && !j.Archived() {
+				jobs[j.Name] = j
+			}
+		}
+		if _, ok := links[web.KeyNextLink]; !ok {
+			return jobs, nil
+		}
+	}
+}
+
+// jobSpecDrift returns the fields of the job's export that differ from want.
+func (cli *Client) jobSpecDrift(id string, want map[string]interface{}) ([]string, error) {
+	resp, err := cli.HTTP.Get("/v2/specs/" + id + "/export")
+	if err != nil {
+		return nil, cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var current map[string]interface{}
+	if err := cli.deserializeResponse(resp, &current); err != nil {
+		return nil, err
+	}
+
+	drift := []string{}
+	for field, value := range want {
+		if !reflect.DeepEqual(value, current[field]) {
+			drift = append(drift, field)
+		}
+	}
+	for field := range current {
+		if _, ok := want[field]; !ok {
+			drift = append(drift, field)
+		}
+	}
+	sort.Strings(drift)
+	return drift, nil
+}
+
+func (cli *Client) createJobSpec(raw []byte) (string, error) {
+	resp, err := cli.HTTP.Post("/v2/specs", bytes.NewBuffer(raw))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	var js models.JobSpec
+	if err := cli.deserializeAPIResponse(resp, &js, &jsonapi.Links{}); err != nil {
+		return "", err
+	}
+	return js.ID, nil
+}
+
+func (cli *Client) archiveJobSpec(id string) error {
+	resp, err := cli.HTTP.Delete("/v2/specs/" + id)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, err = parseResponse(resp)
+	return err
+}
+
 // CreateJobRun creates job run based on SpecID and optional JSON
 func (cli *Client) CreateJobRun(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -150,6 +548,56 @@ func (cli *Client) CreateJobRun(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &run)
 }
 
+// ShowEarnings reports the LINK earned and gas spent by the given JobID, or
+// by every job on the node when no JobID is passed.
+func (cli *Client) ShowEarnings(c *clipkg.Context) error {
+	if c.Args().Present() {
+		resp, err := cli.HTTP.Get("/v2/specs/" + c.Args().First() + "/earnings")
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		defer resp.Body.Close()
+		var earnings presenters.JobEarnings
+		return cli.renderAPIResponse(resp, &earnings)
+	}
+
+	resp, err := cli.HTTP.Get("/v2/earnings")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var summary presenters.EarningsSummary
+	return cli.renderAPIResponse(resp, &summary)
+}
+
+// SigningAccount shows the account service agreements are signed with, or
+// rotates to the account with the address passed. Agreements signed earlier
+// keep their signing address.
+func (cli *Client) SigningAccount(c *clipkg.Context) error {
+	var resp *http.Response
+	var err error
+	if c.Args().Present() {
+		address := c.Args().First()
+		if !common.IsHexAddress(address) {
+			return cli.errorOut(fmt.Errorf("%s is not a valid address", address))
+		}
+		request := models.RotateSigningAccountRequest{Address: common.HexToAddress(address)}
+		requestData, merr := json.Marshal(request)
+		if merr != nil {
+			return cli.errorOut(merr)
+		}
+		resp, err = cli.HTTP.Patch("/v2/signing_account", bytes.NewBuffer(requestData))
+	} else {
+		resp, err = cli.HTTP.Get("/v2/signing_account")
+	}
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var account presenters.SigningAccount
+	return cli.renderAPIResponse(resp, &account)
+}
+
 // BackupDatabase streams a backup of the node's db to the passed filepath.
 func (cli *Client) BackupDatabase(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -163,6 +611,159 @@ func (cli *Client) BackupDatabase(c *clipkg.Context) error {
 	return cli.errorOut(saveBodyAsFile(resp, c.Args().First()))
 }
 
+// CompactDatabase requests that the database of the running node be
+// compacted the next time it starts, or withdraws the request with --cancel.
+// Nothing is compacted until the node is restarted.
+func (cli *Client) CompactDatabase(c *clipkg.Context) error {
+	var resp *http.Response
+	var err error
+	if c.Bool("cancel") {
+		resp, err = cli.HTTP.Delete("/v2/compaction")
+	} else {
+		resp, err = cli.HTTP.Post("/v2/compaction", nil)
+	}
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var compaction presenters.Compaction
+	return cli.renderAPIResponse(resp, &compaction)
+}
+
+// ExportJobRuns streams completed job runs as CSV or NDJSON to the passed
+// filepath.
+func (cli *Client) ExportJobRuns(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the path to save the export"))
+	}
+	params := url.Values{}
+	for _, flag := range []string{"format", "from", "to"} {
+		if val := c.String(flag); val != "" {
+			params.Set(flag, val)
+		}
+	}
+
+	resp, err := cli.HTTP.Get("/v2/runs/export?" + params.Encode())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		_, err = cli.parseResponse(resp)
+		return err
+	}
+	return cli.errorOut(saveBodyAsFile(resp, c.Args().First()))
+}
+
+// CreateBulkDeleteRuns queues a task deleting the runs that have one of the
+// given statuses and match each of the other filters passed.
+func (cli *Client) CreateBulkDeleteRuns(c *clipkg.Context) error {
+	request := models.BulkDeleteRunRequest{
+		JobSpecIDs:     c.StringSlice("job"),
+		InitiatorTypes: c.StringSlice("initiator"),
+	}
+	for _, status := range c.StringSlice("status") {
+		request.Status = append(request.Status, models.RunStatus(status))
+	}
+	if len(request.Status) == 0 {
+		return cli.errorOut(errors.New("Must pass at least one --status of the runs to delete"))
+	}
+	for flag, dst := range map[string]*time.Time{
+		"updated-before":   &request.UpdatedBefore,
+		"completed-before": &request.CompletedBefore,
+	} {
+		if val := c.String(flag); val != "" {
+			t, err := time.Parse(time.RFC3339, val)
+			if err != nil {
+				return cli.errorOut(fmt.Errorf("--%s must be an RFC3339 time: %v", flag, err))
+			}
+			*dst = t
+		}
+	}
+
+	b, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/bulk_delete_runs", bytes.NewBuffer(b))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var task models.BulkDeleteRunTask
+	if !c.Bool("watch") {
+		return cli.renderAPIResponse(resp, &task)
+	}
+	if err := cli.deserializeAPIResponse(resp, &task, &jsonapi.Links{}); err != nil {
+		return err
+	}
+	return cli.watchBulkDeleteRuns(task.ID)
+}
+
+// ShowBulkDeleteRuns renders the progress of the bulk delete task with the
+// given ID, until it finishes if --watch is passed.
+func (cli *Client) ShowBulkDeleteRuns(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the ID of the bulk delete task to show"))
+	}
+	if c.Bool("watch") {
+		return cli.watchBulkDeleteRuns(c.Args().First())
+	}
+	task, err := cli.getBulkDeleteRuns(c.Args().First())
+	if err != nil {
+		return err
+	}
+	return cli.errorOut(cli.Render(&task))
+}
+
+// CancelBulkDeleteRuns stops the bulk delete task with the given ID. Runs it
+// has already deleted are not restored.
+func (cli *Client) CancelBulkDeleteRuns(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the ID of the bulk delete task to cancel"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/bulk_delete_runs/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var task models.BulkDeleteRunTask
+	return cli.renderAPIResponse(resp, &task)
+}
+
+func (cli *Client) getBulkDeleteRuns(id string) (models.BulkDeleteRunTask, error) {
+	var task models.BulkDeleteRunTask
+	resp, err := cli.HTTP.Get("/v2/bulk_delete_runs/" + id)
+	if err != nil {
+		return task, cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	return task, cli.deserializeAPIResponse(resp, &task, &jsonapi.Links{})
+}
+
+// watchBulkDeleteRuns renders the task each time its progress changes, until
+// it is no longer in progress.
+func (cli *Client) watchBulkDeleteRuns(id string) error {
+	var last *models.BulkDeleteRunTask
+	for {
+		task, err := cli.getBulkDeleteRuns(id)
+		if err != nil {
+			return err
+		}
+		if last == nil || task.Scanned != last.Scanned || task.Status != last.Status {
+			if err := cli.Render(&task); err != nil {
+				return cli.errorOut(err)
+			}
+		}
+		if task.Status != models.BulkTaskStatusInProgress {
+			return nil
+		}
+		last = &task
+		time.Sleep(bulkDeleteWatchInterval)
+	}
+}
+
 func saveBodyAsFile(resp *http.Response, dst string) error {
 	out, err := os.Create(dst)
 	if err != nil {
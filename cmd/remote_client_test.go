@@ -2,20 +2,27 @@ package cmd_test
 
 import (
 	"flag"
+	"io/ioutil"
+	"os"
 	"path"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/smartcontractkit/chainlink/cmd"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/migrations"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/store/orm"
 	"github.com/smartcontractkit/chainlink/store/presenters"
+	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
+	null "gopkg.in/guregu/null.v3"
 )
 
 func TestClient_DisplayAccountBalance(t *testing.T) {
@@ -52,6 +59,70 @@ func TestClient_GetJobSpecs(t *testing.T) {
 	assert.Equal(t, j1.ID, jobs[0].ID)
 }
 
+func TestClient_GetJobSpecs_Filter(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	tagged, _ := cltest.NewJobWithWebInitiator()
+	tagged.Name = "eth-usd"
+	tagged.Tags = []string{"eth", "price-feed"}
+	require.NoError(t, app.Store.SaveJob(&tagged))
+	untagged, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&untagged))
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("jobspecs", 0)
+	set.String("name", "", "")
+	set.String("tag", "", "")
+	require.NoError(t, set.Parse([]string{"--tag", "eth,price-feed"}))
+	require.NoError(t, client.GetJobSpecs(cli.NewContext(nil, set, nil)))
+	jobs := *r.Renders[0].(*[]models.JobSpec)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, tagged.ID, jobs[0].ID)
+
+	set = flag.NewFlagSet("jobspecs", 0)
+	set.String("name", "", "")
+	require.NoError(t, set.Parse([]string{"--name", "btc-usd"}))
+	require.NoError(t, client.GetJobSpecs(cli.NewContext(nil, set, nil)))
+	assert.Empty(t, *r.Renders[1].(*[]models.JobSpec))
+}
+
+func TestClient_GetJobRuns(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j1, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&j1))
+	j2, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&j2))
+
+	completed := j1.NewRun(initr)
+	completed.Status = models.RunStatusCompleted
+	require.NoError(t, app.Store.SaveJobRun(&completed))
+	errored := j1.NewRun(initr)
+	errored.Status = models.RunStatusErrored
+	require.NoError(t, app.Store.SaveJobRun(&errored))
+	otherJob := j2.NewRun(initr)
+	otherJob.Status = models.RunStatusErrored
+	require.NoError(t, app.Store.SaveJobRun(&otherJob))
+
+	client, r := app.NewClientAndRenderer()
+
+	statuses := cli.StringSlice{"errored"}
+	set := flag.NewFlagSet("test", 0)
+	set.String("job", j1.ID, "")
+	set.Var(&statuses, "status", "")
+	c := cli.NewContext(nil, set, nil)
+
+	require.NoError(t, client.GetJobRuns(c))
+	runs := *r.Renders[0].(*[]presenters.JobRun)
+	require.Len(t, runs, 1)
+	assert.Equal(t, errored.ID, runs[0].ID)
+}
+
 func TestClient_ShowJobRun_Exists(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication()
@@ -188,6 +259,191 @@ func TestClient_CreateJobSpec(t *testing.T) {
 	}
 }
 
+func TestClient_UpdateJobSpec(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, r := app.NewClientAndRenderer()
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&job))
+
+	tests := []struct {
+		name        string
+		args        []string
+		wantVersion uint32
+		errored     bool
+	}{
+		{"missing json", []string{job.ID}, 1, true},
+		{"unknown job", []string{"bogus", `{"name":"x"}`}, 1, true},
+		{"unknown field", []string{job.ID, `{"id":"x"}`}, 1, true},
+		{"name", []string{job.ID, `{"name":"renamed"}`}, 2, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("update", 0)
+			set.Parse(test.args)
+			c := cli.NewContext(nil, set, nil)
+
+			err := client.UpdateJobSpec(c)
+			cltest.AssertError(t, test.errored, err)
+
+			found, err := app.Store.FindJob(job.ID)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantVersion, found.Version)
+		})
+	}
+
+	require.Len(t, r.Renders, 1)
+	assert.Equal(t, "renamed", r.Renders[0].(*presenters.JobSpec).Name)
+}
+
+func TestClient_GetJobSpecVersions(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, r := app.NewClientAndRenderer()
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&job))
+	next, err := job.NewVersion([]byte(`{"name":"renamed"}`))
+	require.NoError(t, err)
+	require.NoError(t, app.UpdateJob(&next))
+
+	set := flag.NewFlagSet("versions", 0)
+	set.Parse([]string{job.ID})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.GetJobSpecVersions(c))
+
+	versions := *r.Renders[0].(*[]models.JobSpecVersion)
+	require.Len(t, versions, 2)
+	assert.Equal(t, uint32(1), versions[0].Version)
+	assert.Equal(t, "renamed", versions[1].Name)
+}
+
+func TestClient_DiffJobSpec(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, r := app.NewClientAndRenderer()
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&job))
+	next, err := job.NewVersion([]byte(`{"name":"renamed"}`))
+	require.NoError(t, err)
+	require.NoError(t, app.UpdateJob(&next))
+
+	set := flag.NewFlagSet("diff", 0)
+	set.String("from", "", "")
+	set.Parse([]string{job.ID, "2"})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.DiffJobSpec(c))
+
+	diff := r.Renders[0].(*presenters.JobSpecDiff)
+	assert.Equal(t, uint32(1), diff.From)
+	assert.Equal(t, uint32(2), diff.To)
+	assert.Equal(t, []presenters.JobSpecChange{{Path: "name", From: nil, To: "renamed"}}, diff.Changes)
+
+	set = flag.NewFlagSet("diff", 0)
+	set.String("from", "", "")
+	set.Parse([]string{"--from", "3", job.ID, "2"})
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.DiffJobSpec(c))
+}
+
+func TestClient_SyncJobSpecs(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, r := app.NewClientAndRenderer()
+
+	dir, err := ioutil.TempDir("", "job-specs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	specs := map[string]string{
+		"drifted":   `{"name":"drifted","initiators":[{"type":"web"}],"tasks":[{"type":"NoOp"}]}`,
+		"new":       `{"name":"new","initiators":[{"type":"web"}],"tasks":[{"type":"NoOp"}]}`,
+		"unchanged": `{"name":"unchanged","initiators":[{"type":"web"}],"tasks":[{"type":"NoOp"}]}`,
+	}
+	for name, spec := range specs {
+		require.NoError(t, ioutil.WriteFile(path.Join(dir, name+".json"), []byte(spec), 0600))
+	}
+
+	unchanged, _ := cltest.NewJobWithWebInitiator()
+	unchanged.Name = "unchanged"
+	require.NoError(t, app.AddJob(unchanged))
+	drifted, _ := cltest.NewJobWithWebInitiator()
+	drifted.Name = "drifted"
+	drifted.Tasks = append(drifted.Tasks, cltest.NewTask("noop"))
+	require.NoError(t, app.AddJob(drifted))
+	removed, _ := cltest.NewJobWithWebInitiator()
+	removed.Name = "removed"
+	require.NoError(t, app.AddJob(removed))
+	unnamed, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.AddJob(unnamed))
+
+	set := flag.NewFlagSet("sync", 0)
+	set.Bool("dry-run", true, "")
+	require.NoError(t, set.Parse([]string{"--dry-run", dir}))
+	require.NoError(t, client.SyncJobSpecs(cli.NewContext(nil, set, nil)))
+
+	results := *r.Renders[0].(*[]presenters.JobSyncResult)
+	require.Len(t, results, 4)
+	assert.Equal(t, presenters.JobSyncResult{Name: "drifted", JobID: drifted.ID, Action: "drifted", Drift: []string{"tasks"}}, results[0])
+	assert.Equal(t, presenters.JobSyncResult{Name: "new", Action: "create"}, results[1])
+	assert.Equal(t, presenters.JobSyncResult{Name: "unchanged", JobID: unchanged.ID, Action: "unchanged", Drift: []string{}}, results[2])
+	assert.Equal(t, presenters.JobSyncResult{Name: "removed", JobID: removed.ID, Action: "archive"}, results[3])
+	assert.Len(t, cltest.AllJobs(app.Store), 4)
+
+	set = flag.NewFlagSet("sync", 0)
+	require.NoError(t, set.Parse([]string{dir}))
+	require.NoError(t, client.SyncJobSpecs(cli.NewContext(nil, set, nil)))
+
+	created, err := app.Store.FindActiveJobByName("new")
+	require.NoError(t, err)
+	results = *r.Renders[1].(*[]presenters.JobSyncResult)
+	assert.Equal(t, created.ID, results[1].JobID)
+
+	_, err = app.Store.FindActiveJobByName("removed")
+	assert.Equal(t, orm.ErrorNotFound, err)
+	found, err := app.Store.FindJob(unnamed.ID)
+	require.NoError(t, err)
+	assert.False(t, found.Archived())
+}
+
+func TestClient_SyncJobSpecs_InvalidDir(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, _ := app.NewClientAndRenderer()
+
+	dir, err := ioutil.TempDir("", "job-specs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, ioutil.WriteFile(path.Join(dir, "unnamed.json"), []byte(`{"initiators":[{"type":"web"}],"tasks":[{"type":"NoOp"}]}`), 0600))
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"no directory", []string{}},
+		{"missing directory", []string{path.Join(dir, "missing")}},
+		{"unnamed spec", []string{dir}},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("sync", 0)
+			require.NoError(t, set.Parse(test.args))
+			assert.Error(t, client.SyncJobSpecs(cli.NewContext(nil, set, nil)))
+		})
+	}
+	assert.Len(t, cltest.AllJobs(app.Store), 0)
+}
+
 func TestClient_CreateJobSpec_JSONAPIErrors(t *testing.T) {
 	app, cleanup := cltest.NewApplication()
 	defer cleanup()
@@ -283,6 +539,136 @@ func TestClient_AddBridge(t *testing.T) {
 	}
 }
 
+func TestClient_GetServiceAgreements(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	sa, err := cltest.ServiceAgreementFromString(string(cltest.LoadJSON("../internal/fixtures/web/hello_world_agreement.json")))
+	require.NoError(t, err)
+	require.NoError(t, app.Store.SaveServiceAgreement(&sa))
+
+	client, r := app.NewClientAndRenderer()
+
+	require.Nil(t, client.GetServiceAgreements(cltest.EmptyCLIContext()))
+	sas := *r.Renders[0].(*[]presenters.ServiceAgreement)
+	require.Len(t, sas, 1)
+	assert.Equal(t, sa.ID, sas[0].ID)
+	assert.Equal(t, sa.Encumbrance.Payment, sas[0].Encumbrance.Payment)
+}
+
+func TestClient_ShowServiceAgreement(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	sa, err := cltest.ServiceAgreementFromString(string(cltest.LoadJSON("../internal/fixtures/web/hello_world_agreement.json")))
+	require.NoError(t, err)
+	require.NoError(t, app.Store.SaveServiceAgreement(&sa))
+
+	client, r := app.NewClientAndRenderer()
+
+	assert.Error(t, client.ShowServiceAgreement(cltest.EmptyCLIContext()))
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{sa.ID})
+	c := cli.NewContext(nil, set, nil)
+	require.Nil(t, client.ShowServiceAgreement(c))
+	require.Equal(t, 1, len(r.Renders))
+	shown := r.Renders[0].(*presenters.ServiceAgreement)
+	assert.Equal(t, sa.ID, shown.ID)
+	assert.Equal(t, sa.SigningAddress, shown.SigningAddress)
+}
+
+func TestClient_VerifyServiceAgreement(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, r := app.NewClientAndRenderer()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	oracle := crypto.PubkeyToAddress(key.PublicKey)
+	agreement := cltest.EasyJSONFromFixture("../internal/fixtures/web/hello_world_agreement.json").
+		Add("oracles", []string{oracle.Hex()})
+	us, err := models.NewUnsignedServiceAgreementFromRequest(strings.NewReader(agreement.String()))
+	require.NoError(t, err)
+	hash, err := utils.Keccak256(us.ID.Bytes())
+	require.NoError(t, err)
+	output, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	signature := models.BytesToSignature(output)
+
+	tests := []struct {
+		name      string
+		args      []string
+		errored   bool
+		wantValid bool
+	}{
+		{"no agreement", []string{"--signature", signature.Hex()}, true, false},
+		{"no signature", []string{agreement.String()}, true, false},
+		{"invalid address", []string{"--signature", signature.Hex(), "--address", "0xbad", agreement.String()}, true, false},
+		{"signed by oracle", []string{"--signature", signature.Hex(), agreement.String()}, false, true},
+		{"signed by another address", []string{"--signature", signature.Hex(), "--address", cltest.NewAddress().Hex(), agreement.String()}, false, false},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("verifyagreement", 0)
+			set.String("signature", "", "")
+			set.String("address", "", "")
+			require.NoError(t, set.Parse(test.args))
+			c := cli.NewContext(nil, set, nil)
+
+			err := client.VerifyServiceAgreement(c)
+			cltest.AssertError(t, test.errored, err)
+			if !test.errored {
+				sav := r.Renders[len(r.Renders)-1].(*models.ServiceAgreementVerification)
+				assert.Equal(t, oracle, sav.Signer)
+				assert.Equal(t, test.wantValid, sav.Valid)
+			}
+		})
+	}
+}
+
+func TestClient_CoordinateServiceAgreement(t *testing.T) {
+	config, _ := cltest.NewConfigWithPrivateKey()
+	app, cleanup := cltest.NewApplicationWithConfigAndUnlockedAccount(config)
+	defer cleanup()
+	eth := cltest.MockEthOnStore(app.GetStore())
+	eth.RegisterSubscription("logs")
+	client, r := app.NewClientAndRenderer()
+
+	account := cltest.GetAccountAddress(app.Store)
+	agreement := cltest.EasyJSONFromFixture("../internal/fixtures/web/hello_world_agreement.json").
+		Add("oracles", []string{account.Hex()})
+
+	assert.Error(t, client.CoordinateServiceAgreement(cltest.EmptyCLIContext()))
+
+	tests := []struct {
+		name    string
+		nodes   cli.StringSlice
+		errored bool
+	}{
+		{"no nodes", cli.StringSlice{}, true},
+		{"invalid node URL", cli.StringSlice{"not a url"}, true},
+		{"signed by every oracle", cli.StringSlice{app.Server.URL}, false},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("coordinate", 0)
+			set.Var(&test.nodes, "node", "")
+			require.NoError(t, set.Parse([]string{agreement.String()}))
+			c := cli.NewContext(nil, set, nil)
+
+			err := client.CoordinateServiceAgreement(c)
+			cltest.AssertError(t, test.errored, err)
+			if !test.errored {
+				csa := r.Renders[len(r.Renders)-1].(*models.CoordinatorServiceAgreement)
+				assert.Equal(t, []common.Address{account}, csa.Oracles)
+			}
+		})
+	}
+}
+
 func TestClient_GetBridges(t *testing.T) {
 	app, cleanup := cltest.NewApplication()
 	defer cleanup()
@@ -348,6 +734,59 @@ func TestClient_RemoveBridge(t *testing.T) {
 	assert.Equal(t, bt.Name, r.Renders[0].(*models.BridgeType).Name)
 }
 
+func TestClient_ShowEarnings(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, r := app.NewClientAndRenderer()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&job))
+	run := job.NewRun(initr)
+	run.Status = models.RunStatusCompleted
+	run.Result.Amount = assets.NewLink(5)
+	require.NoError(t, app.Store.SaveJobRun(&run))
+
+	require.NoError(t, client.ShowEarnings(cltest.EmptyCLIContext()))
+	summary := r.Renders[0].(*presenters.EarningsSummary)
+	assert.Equal(t, 1, summary.RunCount)
+	assert.Equal(t, assets.NewLink(5), summary.LinkEarned)
+
+	set := flag.NewFlagSet("earnings", 0)
+	set.Parse([]string{job.ID})
+	require.NoError(t, client.ShowEarnings(cli.NewContext(nil, set, nil)))
+	earnings := r.Renders[1].(*presenters.JobEarnings)
+	assert.Equal(t, job.ID, earnings.JobID)
+	require.Len(t, earnings.Runs, 1)
+	assert.Equal(t, run.ID, earnings.Runs[0].RunID)
+}
+
+func TestClient_SigningAccount(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	extra, err := app.Store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	require.NoError(t, app.Start())
+	client, r := app.NewClientAndRenderer()
+
+	require.NoError(t, client.SigningAccount(cltest.EmptyCLIContext()))
+	account := r.Renders[0].(*presenters.SigningAccount)
+	assert.Equal(t, cltest.GetAccountAddress(app.Store), account.Address)
+
+	set := flag.NewFlagSet("signingaccount", 0)
+	set.Parse([]string{extra.Address.Hex()})
+	require.NoError(t, client.SigningAccount(cli.NewContext(nil, set, nil)))
+	account = r.Renders[1].(*presenters.SigningAccount)
+	assert.Equal(t, extra.Address, account.Address)
+
+	set = flag.NewFlagSet("signingaccount", 0)
+	set.Parse([]string{"notanaddress"})
+	assert.Error(t, client.SigningAccount(cli.NewContext(nil, set, nil)))
+}
+
 func TestClient_BackupDatabase(t *testing.T) {
 	t.Parallel()
 
@@ -378,6 +817,176 @@ func TestClient_BackupDatabase(t *testing.T) {
 	assert.Equal(t, reloaded, restoredJob)
 }
 
+func TestClient_CompactDatabase(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("compact", 0)
+	set.Bool("cancel", false, "")
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.CompactDatabase(c))
+	require.Len(t, r.Renders, 1)
+	assert.True(t, r.Renders[0].(*presenters.Compaction).Requested)
+	assert.True(t, app.Store.CompactionRequested())
+
+	set = flag.NewFlagSet("compact", 0)
+	set.Bool("cancel", true, "")
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.CompactDatabase(c))
+	require.Len(t, r.Renders, 2)
+	assert.False(t, r.Renders[1].(*presenters.Compaction).Requested)
+	assert.False(t, app.Store.CompactionRequested())
+}
+
+func TestClient_ExportJobRuns(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, _ := app.NewClientAndRenderer()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&job))
+	run := job.NewRun(initr)
+	run.Status = models.RunStatusCompleted
+	run.CompletedAt = null.TimeFrom(cltest.ParseISO8601("2018-01-01T01:00:00Z"))
+	require.NoError(t, app.Store.SaveJobRun(&run))
+
+	set := flag.NewFlagSet("exportruns", 0)
+	set.String("format", "ndjson", "")
+	set.String("from", "2018-01-01T00:00:00Z", "")
+	path := path.Join(app.Store.Config.RootDir(), "runs.ndjson")
+	set.Parse([]string{path})
+	c := cli.NewContext(nil, set, nil)
+
+	require.NoError(t, client.ExportJobRuns(c))
+
+	exported, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(exported), run.ID)
+}
+
+func TestClient_ExportJobRuns_InvalidFormat(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, _ := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("exportruns", 0)
+	set.String("format", "xml", "")
+	set.Parse([]string{path.Join(app.Store.Config.RootDir(), "runs.xml")})
+	c := cli.NewContext(nil, set, nil)
+
+	assert.Error(t, client.ExportJobRuns(c))
+}
+
+func TestClient_CreateBulkDeleteRuns(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, r := app.NewClientAndRenderer()
+
+	tests := []struct {
+		name    string
+		args    []string
+		errored bool
+	}{
+		{"no status", []string{"--updated-before", "2018-11-28T21:24:03Z"}, true},
+		{"invalid time", []string{"--status", "completed", "--completed-before", "yesterday"}, true},
+		{"invalid status", []string{"--status", "in_progress", "--updated-before", "2018-11-28T21:24:03Z"}, true},
+		{"no time bound", []string{"--status", "completed"}, true},
+		{"all filters", []string{
+			"--status", "completed",
+			"--status", "errored",
+			"--job", "a1b2c3",
+			"--initiator", "web",
+			"--updated-before", "2018-11-28T21:24:03Z",
+			"--completed-before", "2018-11-27T21:24:03Z",
+		}, false},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("bulkdeleteruns", 0)
+			set.Var(&cli.StringSlice{}, "status", "")
+			set.Var(&cli.StringSlice{}, "job", "")
+			set.Var(&cli.StringSlice{}, "initiator", "")
+			set.String("updated-before", "", "")
+			set.String("completed-before", "", "")
+			set.Bool("watch", false, "")
+			require.NoError(t, set.Parse(test.args))
+			c := cli.NewContext(nil, set, nil)
+
+			err := client.CreateBulkDeleteRuns(c)
+			if test.errored {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			task := *r.Renders[len(r.Renders)-1].(*models.BulkDeleteRunTask)
+			assert.Equal(t, models.BulkTaskStatusInProgress, task.Status)
+			assert.Equal(t, []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored}, task.Query.Status)
+			assert.Equal(t, []string{"a1b2c3"}, task.Query.JobSpecIDs)
+			assert.Equal(t, []string{"web"}, task.Query.InitiatorTypes)
+			assert.Equal(t, cltest.ParseISO8601("2018-11-27T21:24:03Z"), task.Query.CompletedBefore)
+
+			_, err = app.Store.FindBulkDeleteRunTask(task.ID)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_ShowBulkDeleteRuns(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, r := app.NewClientAndRenderer()
+
+	task, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{UpdatedBefore: time.Now()})
+	require.NoError(t, err)
+	task.Status = models.BulkTaskStatusCompleted
+	task.Scanned, task.Deleted = 10, 4
+	require.NoError(t, app.Store.SaveBulkDeleteRunTask(task))
+
+	set := flag.NewFlagSet("showbulkdelete", 0)
+	set.Bool("watch", true, "")
+	set.Parse([]string{task.ID})
+	c := cli.NewContext(nil, set, nil)
+
+	require.NoError(t, client.ShowBulkDeleteRuns(c))
+	require.Len(t, r.Renders, 1)
+	shown := *r.Renders[0].(*models.BulkDeleteRunTask)
+	assert.Equal(t, task.ID, shown.ID)
+	assert.Equal(t, uint64(10), shown.Scanned)
+	assert.Equal(t, uint64(4), shown.Deleted)
+
+	set = flag.NewFlagSet("showbulkdelete", 0)
+	set.Parse([]string{"bogus"})
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ShowBulkDeleteRuns(c))
+}
+
+func TestClient_CancelBulkDeleteRuns(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client, r := app.NewClientAndRenderer()
+
+	task, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{UpdatedBefore: time.Now()})
+	require.NoError(t, err)
+	require.NoError(t, app.Store.SaveBulkDeleteRunTask(task))
+
+	set := flag.NewFlagSet("cancelbulkdelete", 0)
+	set.Parse([]string{task.ID})
+	c := cli.NewContext(nil, set, nil)
+
+	require.NoError(t, client.CancelBulkDeleteRuns(c))
+	require.Len(t, r.Renders, 1)
+	assert.Equal(t, models.BulkTaskStatusCancelled, r.Renders[0].(*models.BulkDeleteRunTask).Status)
+
+	assert.Error(t, client.CancelBulkDeleteRuns(c))
+}
+
 func TestClient_RemoteLogin(t *testing.T) {
 	t.Parallel()
 
@@ -1,9 +1,11 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/smartcontractkit/chainlink/store/models"
@@ -48,6 +50,8 @@ func (rt RendererTable) Render(v interface{}) error {
 		rt.renderJob(*typed)
 	case *presenters.JobRun:
 		rt.renderJobRun(*typed)
+	case *[]presenters.JobRun:
+		rt.renderJobRuns(*typed)
 	case *models.BridgeType:
 		rt.renderBridge(*typed)
 	case *[]models.BridgeType:
@@ -56,8 +60,30 @@ func (rt RendererTable) Render(v interface{}) error {
 		rt.renderAccountBalances(*typed)
 	case *presenters.ServiceAgreement:
 		rt.renderServiceAgreement(*typed)
+	case *[]presenters.ServiceAgreement:
+		rt.renderServiceAgreements(*typed)
+	case *models.CoordinatorServiceAgreement:
+		rt.renderCoordinatorServiceAgreement(*typed)
+	case *models.ServiceAgreementVerification:
+		rt.renderServiceAgreementVerification(*typed)
 	case *[]models.TxAttempt:
 		rt.renderTxAttempts(*typed)
+	case *presenters.JobEarnings:
+		rt.renderJobEarnings(*typed)
+	case *presenters.EarningsSummary:
+		rt.renderEarningsSummary(*typed)
+	case *presenters.SigningAccount:
+		rt.renderSigningAccount(*typed)
+	case *models.BulkDeleteRunTask:
+		rt.renderBulkDeleteRunTask(*typed)
+	case *presenters.Compaction:
+		rt.renderCompaction(*typed)
+	case *[]presenters.JobSyncResult:
+		rt.renderJobSyncResults(*typed)
+	case *[]models.JobSpecVersion:
+		rt.renderJobSpecVersions(*typed)
+	case *presenters.JobSpecDiff:
+		rt.renderJobSpecDiff(*typed)
 	default:
 		return fmt.Errorf("Unable to render object of type %T: %v", typed, typed)
 	}
@@ -66,7 +92,7 @@ func (rt RendererTable) Render(v interface{}) error {
 }
 
 func (rt RendererTable) renderJobs(jobs []models.JobSpec) error {
-	table := rt.newTable([]string{"ID", "Created At", "Initiators", "Tasks"})
+	table := rt.newTable([]string{"ID", "Name", "Tags", "Created At", "Initiators", "Tasks"})
 	for _, v := range jobs {
 		table.Append(jobRowToStrings(v))
 	}
@@ -89,6 +115,8 @@ func jobRowToStrings(job models.JobSpec) []string {
 	p := presenters.JobSpec{JobSpec: job, Runs: nil}
 	return []string{
 		p.ID,
+		p.Name,
+		p.FriendlyTags(),
 		p.FriendlyCreatedAt(),
 		p.FriendlyInitiators(),
 		p.FriendlyTasks(),
@@ -149,9 +177,13 @@ func (rt RendererTable) renderJobRun(run presenters.JobRun) error {
 }
 
 func (rt RendererTable) renderJobSingles(j presenters.JobSpec) error {
-	table := rt.newTable([]string{"ID", "Created At", "Start At", "End At"})
+	table := rt.newTable([]string{"ID", "Version", "Name", "Description", "Tags", "Created At", "Start At", "End At"})
 	table.Append([]string{
 		j.ID,
+		fmt.Sprint(j.Version),
+		j.Name,
+		j.Description,
+		j.FriendlyTags(),
 		j.FriendlyCreatedAt(),
 		j.FriendlyStartAt(),
 		j.FriendlyEndAt(),
@@ -220,17 +252,154 @@ func (rt RendererTable) renderAccountBalances(balances []presenters.AccountBalan
 }
 
 func (rt RendererTable) renderServiceAgreement(sa presenters.ServiceAgreement) error {
-	table := rt.newTable([]string{"ID", "Created At", "Payment", "Expiration"})
-	table.Append([]string{
+	table := rt.newTable([]string{"ID", "Created At", "Payment", "Expiration", "End At", "Status", "Signing Address"})
+	table.Append(serviceAgreementRowToStrings(sa))
+	render("Service Agreement", table)
+	return nil
+}
+
+func (rt RendererTable) renderServiceAgreements(sas []presenters.ServiceAgreement) error {
+	table := rt.newTable([]string{"ID", "Created At", "Payment", "Expiration", "End At", "Status", "Signing Address"})
+	for _, sa := range sas {
+		table.Append(serviceAgreementRowToStrings(sa))
+	}
+	render("Service Agreements", table)
+	return nil
+}
+
+func serviceAgreementRowToStrings(sa presenters.ServiceAgreement) []string {
+	return []string{
 		sa.ID,
 		sa.FriendlyCreatedAt(),
 		sa.FriendlyPayment(),
 		sa.FriendlyExpiration(),
+		sa.FriendlyEndAt(),
+		sa.FriendlyStatus(),
+		sa.SigningAddress.Hex(),
+	}
+}
+
+func (rt RendererTable) renderCoordinatorServiceAgreement(csa models.CoordinatorServiceAgreement) error {
+	table := rt.newTable([]string{"ID", "Payment", "Expiration", "End At", "Request Digest"})
+	table.Append([]string{
+		csa.ID.Hex(),
+		csa.Payment.String(),
+		strconv.FormatUint(csa.Expiration, 10),
+		strconv.FormatUint(csa.EndAt, 10),
+		csa.RequestDigest.Hex(),
 	})
-	render("Service Agreement", table)
+	render("Coordinator Service Agreement", table)
+
+	table = rt.newTable([]string{"Oracle", "V", "R", "S"})
+	for i, oracle := range csa.Oracles {
+		table.Append([]string{
+			oracle.Hex(),
+			strconv.FormatUint(csa.Vs[i], 10),
+			csa.Rs[i].Hex(),
+			csa.Ss[i].Hex(),
+		})
+	}
+	render("Oracle Signatures", table)
+	return nil
+}
+
+func (rt RendererTable) renderServiceAgreementVerification(sav models.ServiceAgreementVerification) error {
+	table := rt.newTable([]string{"ID", "Signer", "Oracle", "Valid"})
+	table.Append([]string{
+		sav.ID.Hex(),
+		sav.Signer.Hex(),
+		strconv.FormatBool(sav.Oracle),
+		strconv.FormatBool(sav.Valid),
+	})
+	render("Service Agreement Verification", table)
+	return nil
+}
+
+func (rt RendererTable) renderSigningAccount(sa presenters.SigningAccount) error {
+	table := rt.newTable([]string{"Address"})
+	table.Append([]string{sa.Address.Hex()})
+	render("Signing Account", table)
+	return nil
+}
+
+func (rt RendererTable) renderBulkDeleteRunTask(task models.BulkDeleteRunTask) error {
+	table := rt.newTable([]string{"ID", "Status", "Scanned", "Deleted", "Error"})
+	table.Append([]string{
+		task.ID,
+		string(task.Status),
+		strconv.FormatUint(task.Scanned, 10),
+		strconv.FormatUint(task.Deleted, 10),
+		task.Error,
+	})
+	render("Bulk Delete Runs", table)
+	return nil
+}
+
+func (rt RendererTable) renderCompaction(compaction presenters.Compaction) error {
+	table := rt.newTable([]string{"Requested (Runs On Restart)", "Database Size"})
+	table.Append([]string{
+		strconv.FormatBool(compaction.Requested),
+		strconv.FormatInt(compaction.DatabaseSize, 10),
+	})
+	render("Database Compaction", table)
+	return nil
+}
+
+func (rt RendererTable) renderJobSyncResults(results []presenters.JobSyncResult) error {
+	table := rt.newTable([]string{"Name", "Job ID", "Action", "Drift"})
+	for _, r := range results {
+		table.Append([]string{
+			r.Name,
+			r.JobID,
+			r.Action,
+			strings.Join(r.Drift, ", "),
+		})
+	}
+	render("Job Sync", table)
+	return nil
+}
+
+func (rt RendererTable) renderJobSpecVersions(versions []models.JobSpecVersion) error {
+	table := rt.newTable([]string{"Version", "Name", "Created At", "Initiators", "Tasks"})
+	for _, v := range versions {
+		p := presenters.JobSpec{JobSpec: models.JobSpec{JobSpecRequest: v.JobSpecRequest}}
+		table.Append([]string{
+			fmt.Sprint(v.Version),
+			v.Name,
+			utils.ISO8601UTC(v.CreatedAt),
+			p.FriendlyInitiators(),
+			p.FriendlyTasks(),
+		})
+	}
+	render("Job Versions", table)
 	return nil
 }
 
+func (rt RendererTable) renderJobSpecDiff(diff presenters.JobSpecDiff) error {
+	table := rt.newTable([]string{"Path", fmt.Sprintf("Version %d", diff.From), fmt.Sprintf("Version %d", diff.To)})
+	for _, change := range diff.Changes {
+		table.Append([]string{
+			change.Path,
+			friendlyChangeValue(change.From),
+			friendlyChangeValue(change.To),
+		})
+	}
+	render("Job Diff", table)
+	return nil
+}
+
+func friendlyChangeValue(v interface{}) string {
+	switch typed := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	default:
+		b, _ := json.Marshal(typed)
+		return string(b)
+	}
+}
+
 func (rt RendererTable) newTable(headers []string) *tablewriter.Table {
 	table := tablewriter.NewWriter(rt)
 	table.SetHeader(headers)
@@ -252,3 +421,49 @@ func (rt RendererTable) renderTxAttempts(attempts []models.TxAttempt) error {
 	render("Tx Attempts", table)
 	return nil
 }
+
+func (rt RendererTable) renderJobEarnings(je presenters.JobEarnings) error {
+	table := rt.newTable([]string{"Run ID", "Status", "LINK Earned", "Gas Used", "Gas Cost (ETH)"})
+	for _, re := range je.Runs {
+		table.Append([]string{
+			re.RunID,
+			re.Status,
+			re.LinkEarned.String(),
+			fmt.Sprint(re.GasUsed),
+			re.GasCost.String(),
+		})
+	}
+	table.Append([]string{
+		"Total",
+		fmt.Sprintf("%d runs", je.RunCount),
+		je.LinkEarned.String(),
+		fmt.Sprint(je.GasUsed),
+		je.GasCost.String(),
+	})
+
+	render("Earnings for "+je.JobID, table)
+	return nil
+}
+
+func (rt RendererTable) renderEarningsSummary(es presenters.EarningsSummary) error {
+	table := rt.newTable([]string{"Job ID", "Runs", "LINK Earned", "Gas Used", "Gas Cost (ETH)"})
+	for _, je := range es.Jobs {
+		table.Append([]string{
+			je.JobID,
+			fmt.Sprint(je.RunCount),
+			je.LinkEarned.String(),
+			fmt.Sprint(je.GasUsed),
+			je.GasCost.String(),
+		})
+	}
+	table.Append([]string{
+		"Total",
+		fmt.Sprint(es.RunCount),
+		es.LinkEarned.String(),
+		fmt.Sprint(es.GasUsed),
+		es.GasCost.String(),
+	})
+
+	render("Earnings", table)
+	return nil
+}
@@ -8,8 +8,10 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/cmd"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/store/presenters"
 	"github.com/stretchr/testify/assert"
@@ -31,6 +33,39 @@ func TestRendererTable_RenderJobs(t *testing.T) {
 	assert.NoError(t, r.Render(&jobs))
 }
 
+func TestRendererTable_RenderJobs_Labels(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+	job := cltest.NewJob()
+	job.Name = "eth-usd"
+	job.Tags = []string{"eth", "price-feed"}
+	jobs := []models.JobSpec{job}
+
+	assert.NoError(t, r.Render(&jobs))
+	output := buffer.String()
+	assert.Contains(t, output, "eth-usd")
+	assert.Contains(t, output, "eth, price-feed")
+}
+
+func TestRendererTable_RenderShowJob_Labels(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+	job, _ := cltest.NewJobWithWebInitiator()
+	job.Name = "eth-usd"
+	job.Description = "Median price"
+	job.Tags = []string{"eth"}
+	p := presenters.JobSpec{JobSpec: job}
+
+	assert.NoError(t, r.Render(&p))
+	output := buffer.String()
+	assert.Contains(t, output, "eth-usd")
+	assert.Contains(t, output, "Median price")
+}
+
 func TestRendererTable_RenderShowJob(t *testing.T) {
 	t.Parallel()
 	r := cmd.RendererTable{Writer: ioutil.Discard}
@@ -171,6 +206,185 @@ func TestRendererTable_ServiceAgreementShow(t *testing.T) {
 	assert.Regexp(t, regexp.MustCompile("0x[0-9a-zA-Z]{64}"), output)
 	assert.Regexp(t, regexp.MustCompile("1.000000000000000000 LINK"), output)
 	assert.Regexp(t, regexp.MustCompile("300 seconds"), output)
+	assert.Contains(t, output, cltest.MockSigner{}.Address().Hex())
+	assert.Contains(t, output, psa.FriendlyEndAt())
+	assert.Contains(t, output, psa.FriendlyStatus())
+}
+
+func TestRendererTable_ServiceAgreementList(t *testing.T) {
+	t.Parallel()
+
+	sa, err := cltest.ServiceAgreementFromString(string(cltest.LoadJSON("../internal/fixtures/web/hello_world_agreement.json")))
+	assert.NoError(t, err)
+	psas := []presenters.ServiceAgreement{{ServiceAgreement: sa}}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	assert.NoError(t, r.Render(&psas))
+	output := buffer.String()
+	assert.Contains(t, output, sa.ID)
+	assert.Contains(t, output, psas[0].FriendlyStatus())
+}
+
+func TestRendererTable_CoordinatorServiceAgreement(t *testing.T) {
+	t.Parallel()
+
+	csa := models.CoordinatorServiceAgreement{
+		ID:            cltest.NewHash(),
+		Payment:       assets.NewLink(1),
+		Expiration:    300,
+		EndAt:         1571523439,
+		Oracles:       []common.Address{cltest.NewAddress()},
+		Vs:            []uint64{28},
+		Rs:            []common.Hash{cltest.NewHash()},
+		Ss:            []common.Hash{cltest.NewHash()},
+		RequestDigest: cltest.NewHash(),
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	assert.NoError(t, r.Render(&csa))
+	output := buffer.String()
+	assert.Contains(t, output, csa.ID.Hex())
+	assert.Contains(t, output, "1571523439")
+	assert.Contains(t, output, csa.RequestDigest.Hex())
+	assert.Contains(t, output, csa.Oracles[0].Hex())
+	assert.Contains(t, output, csa.Rs[0].Hex())
+	assert.Contains(t, output, csa.Ss[0].Hex())
+}
+
+func TestRendererTable_ServiceAgreementVerification(t *testing.T) {
+	t.Parallel()
+
+	sav := models.ServiceAgreementVerification{
+		ID:     cltest.NewHash(),
+		Signer: cltest.NewAddress(),
+		Oracle: true,
+		Valid:  false,
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	assert.NoError(t, r.Render(&sav))
+	output := buffer.String()
+	assert.Contains(t, output, sav.ID.Hex())
+	assert.Contains(t, output, sav.Signer.Hex())
+	assert.Contains(t, output, "true")
+	assert.Contains(t, output, "false")
+}
+
+func TestRendererTable_BulkDeleteRunTask(t *testing.T) {
+	t.Parallel()
+
+	task := models.BulkDeleteRunTask{
+		ID:      "f5e1e7b2d32e4b1e8a3c0e5b5d0b0c1a",
+		Status:  models.BulkTaskStatusErrored,
+		Scanned: 1234,
+		Deleted: 567,
+		Error:   "error deleting run",
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	assert.NoError(t, r.Render(&task))
+	output := buffer.String()
+	assert.Contains(t, output, task.ID)
+	assert.Contains(t, output, "errored")
+	assert.Contains(t, output, "1234")
+	assert.Contains(t, output, "567")
+	assert.Contains(t, output, task.Error)
+}
+
+func TestRendererTable_Compaction(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	assert.NoError(t, r.Render(&presenters.Compaction{Requested: true, DatabaseSize: 1048576}))
+	output := buffer.String()
+	assert.Contains(t, output, "true")
+	assert.Contains(t, output, "1048576")
+}
+
+func TestRendererTable_JobSyncResults(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	results := []presenters.JobSyncResult{
+		{Name: "eth-usd", JobID: "a1b2", Action: "drifted", Drift: []string{"initiators", "tasks"}},
+		{Name: "btc-usd", Action: "create"},
+	}
+	assert.NoError(t, r.Render(&results))
+	output := buffer.String()
+	assert.Contains(t, output, "eth-usd")
+	assert.Contains(t, output, "a1b2")
+	assert.Contains(t, output, "initiators, tasks")
+	assert.Contains(t, output, "btc-usd")
+	assert.Contains(t, output, "create")
+}
+
+func TestRendererTable_JobSpecVersions(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	job.Name = "eth-usd"
+	job.Version = 3
+	versions := []models.JobSpecVersion{models.NewJobSpecVersion(job)}
+	assert.NoError(t, r.Render(&versions))
+	output := buffer.String()
+	assert.Contains(t, output, "eth-usd")
+	assert.Contains(t, output, "web")
+}
+
+func TestRendererTable_JobSpecDiff(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	diff := presenters.JobSpecDiff{
+		From: 1,
+		To:   2,
+		Changes: []presenters.JobSpecChange{
+			{Path: "name", From: "eth-usd", To: "btc-usd"},
+			{Path: "tasks.0.params.times", From: nil, To: float64(100)},
+		},
+	}
+	assert.NoError(t, r.Render(&diff))
+	output := buffer.String()
+	assert.Contains(t, output, "Version 2")
+	assert.Contains(t, output, "btc-usd")
+	assert.Contains(t, output, "tasks.0.params.times")
+	assert.Contains(t, output, "100")
+	assert.NotContains(t, output, "<nil>")
+}
+
+func TestRendererTable_RenderEarnings(t *testing.T) {
+	t.Parallel()
+	r := cmd.RendererTable{Writer: ioutil.Discard}
+
+	je := presenters.NewJobEarnings("job")
+	je.Add(presenters.RunEarnings{
+		RunID:      "run",
+		LinkEarned: assets.NewLink(1),
+		GasUsed:    big.NewInt(21000),
+		GasCost:    assets.NewEth(2),
+	})
+	assert.NoError(t, r.Render(&je))
+
+	summary := presenters.NewEarningsSummary()
+	summary.Add(je)
+	assert.NoError(t, r.Render(&summary))
 }
 
 func TestRendererTable_RenderUnknown(t *testing.T) {
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
@@ -534,12 +535,14 @@ func TestIntegration_CreateServiceAgreement(t *testing.T) {
 	assert.NotEqual(t, "", sa.ID)
 
 	// Request execution of the job associated with this ServiceAgreement
-	logs <- cltest.NewServiceAgreementExecutionLog(
+	log := cltest.NewServiceAgreementExecutionLog(
 		j.ID,
 		cltest.NewAddress(),
 		cltest.NewAddress(),
 		1,
 		`{}`)
+	log.Topics[services.ServiceAgreementExecutionLogTopicAmount] = sa.Encumbrance.Payment.ToHash()
+	logs <- log
 
 	runs := cltest.WaitForRuns(t, j, app.Store, 1)
 	cltest.WaitForJobRunToComplete(t, app.Store, runs[0])
@@ -444,6 +444,10 @@ func (r *HTTPClientCleaner) Delete(path string) (*http.Response, func()) {
 	return bodyCleaner(r.HTTPClient.Delete(path))
 }
 
+func (r *HTTPClientCleaner) DeleteWithBody(path string, body io.Reader) (*http.Response, func()) {
+	return bodyCleaner(r.HTTPClient.DeleteWithBody(path, body))
+}
+
 func bodyCleaner(resp *http.Response, err error) (*http.Response, func()) {
 	mustNotErr(err)
 	return resp, func() { mustNotErr(resp.Body.Close()) }
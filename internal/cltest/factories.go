@@ -2,6 +2,7 @@ package cltest
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"crypto/rand"
 	"encoding/json"
 	"flag"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/services"
@@ -110,6 +112,20 @@ func NewJobWithRunAtInitiator(t time.Time) (models.JobSpec, models.Initiator) {
 	return j, j.Initiators[0]
 }
 
+// NewJobWithIntervalInitiator create new Job with an interval initiator
+// triggered every period or every number of blocks
+func NewJobWithIntervalInitiator(period time.Duration, blocks uint64) (models.JobSpec, models.Initiator) {
+	j := NewJob()
+	j.Initiators = []models.Initiator{{
+		Type: models.InitiatorInterval,
+		InitiatorParams: models.InitiatorParams{
+			Period: models.Duration(period),
+			Blocks: blocks,
+		},
+	}}
+	return j, j.Initiators[0]
+}
+
 // NewTx create a tx given from address and sentat
 func NewTx(from common.Address, sentAt uint64) *models.Tx {
 	return &models.Tx{
@@ -407,6 +423,31 @@ func (s MockSigner) Sign(input []byte) (models.Signature, error) {
 	return models.NewSignature("0xb7a987222fc36c4c8ed1b91264867a422769998aadbeeb1c697586a04fa2b616025b5ca936ec5bdb150999e298b6ecf09251d3c4dd1306dedec0692e7037584800")
 }
 
+func (s MockSigner) Address() common.Address {
+	return common.HexToAddress("0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea")
+}
+
+// KeySigner signs service agreements with a private key.
+type KeySigner struct {
+	Key *ecdsa.PrivateKey
+}
+
+func (ks KeySigner) Sign(input []byte) (models.Signature, error) {
+	hash, err := utils.Keccak256(input)
+	if err != nil {
+		return models.Signature{}, err
+	}
+	output, err := crypto.Sign(hash, ks.Key)
+	if err != nil {
+		return models.Signature{}, err
+	}
+	return models.BytesToSignature(output), nil
+}
+
+func (ks KeySigner) Address() common.Address {
+	return crypto.PubkeyToAddress(ks.Key.PublicKey)
+}
+
 func ServiceAgreementFromString(str string) (models.ServiceAgreement, error) {
 	us, err := models.NewUnsignedServiceAgreementFromRequest(strings.NewReader(str))
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/mrwonko/cron"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/cmd"
 	"github.com/smartcontractkit/chainlink/logger"
@@ -463,6 +464,7 @@ func NewHTTPMockServer(
 // MockCron represents a mock cron
 type MockCron struct {
 	Entries []MockCronEntry
+	lastID  services.CronEntryID
 }
 
 // NewMockCron returns a new mock cron
@@ -485,6 +487,32 @@ func (mc *MockCron) AddFunc(schd string, fn func()) error {
 	return nil
 }
 
+// Schedule appends a parsed schedule to mockcron entries
+func (mc *MockCron) Schedule(schd cron.Schedule, job cron.Job) services.CronEntryID {
+	mc.lastID++
+	mc.Entries = append(mc.Entries, MockCronEntry{
+		ID:           mc.lastID,
+		CronSchedule: schd,
+		Function:     job.Run,
+	})
+	return mc.lastID
+}
+
+// Remove deletes the entries with the passed IDs from mockcron entries
+func (mc *MockCron) Remove(ids ...services.CronEntryID) {
+	kept := []MockCronEntry{}
+	for _, entry := range mc.Entries {
+		removed := false
+		for _, id := range ids {
+			removed = removed || entry.ID == id
+		}
+		if !removed {
+			kept = append(kept, entry)
+		}
+	}
+	mc.Entries = kept
+}
+
 // RunEntries run every function for each mockcron entry
 func (mc *MockCron) RunEntries() {
 	for _, entry := range mc.Entries {
@@ -494,8 +522,10 @@ func (mc *MockCron) RunEntries() {
 
 // MockCronEntry a cron schedule and function
 type MockCronEntry struct {
-	Schedule string
-	Function func()
+	ID           services.CronEntryID
+	Schedule     string
+	CronSchedule cron.Schedule
+	Function     func()
 }
 
 // MockHeadTrackable allows you to mock HeadTrackable
@@ -154,6 +154,20 @@ func (mr *MockTxManagerMockRecorder) Disconnect() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnect", reflect.TypeOf((*MockTxManager)(nil).Disconnect))
 }
 
+// GetAvailableAccount mocks base method
+func (m *MockTxManager) GetAvailableAccount(arg0 common.Address) *store.ManagedAccount {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableAccount", arg0)
+	ret0, _ := ret[0].(*store.ManagedAccount)
+	return ret0
+}
+
+// GetAvailableAccount indicates an expected call of GetAvailableAccount
+func (mr *MockTxManagerMockRecorder) GetAvailableAccount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableAccount", reflect.TypeOf((*MockTxManager)(nil).GetAvailableAccount), arg0)
+}
+
 // GetBlockByNumber mocks base method
 func (m *MockTxManager) GetBlockByNumber(arg0 string) (models.BlockHeader, error) {
 	m.ctrl.T.Helper()
@@ -23,7 +23,10 @@ type Application interface {
 	GetStore() *store.Store
 	WakeSessionReaper()
 	WakeBulkRunDeleter()
+	WakeWebhookNotifier()
 	AddJob(job models.JobSpec) error
+	ArchiveJob(ID string) error
+	UpdateJob(job *models.JobSpec) error
 	AddAdapter(bt *models.BridgeType) error
 	RemoveAdapter(bt *models.BridgeType) error
 	NewBox() packr.Box
@@ -34,16 +37,23 @@ type Application interface {
 // in the services package, but the Store has its own package.
 type ChainlinkApplication struct {
 	Exiter                                            func(int)
+	BalanceMonitor                                    *BalanceMonitor
 	HeadTracker                                       *HeadTracker
+	LinkSweeper                                       *LinkSweeper
 	JobRunner                                         JobRunner
 	JobSubscriber                                     JobSubscriber
 	Scheduler                                         *Scheduler
+	ServiceAgreementMonitor                           *ServiceAgreementMonitor
 	Store                                             *store.Store
 	SessionReaper                                     SleeperTask
 	BulkRunDeleter                                    SleeperTask
+	DatabaseBackup                                    SleeperTask
+	WebhookNotifier                                   WebhookNotifier
 	pendingConnectionResumer                          *pendingConnectionResumer
 	bridgeTypeMutex                                   sync.Mutex
 	jobSubscriberID, txManagerID, connectionResumerID string
+	linkSweeperID, balanceMonitorID, saMonitorID      string
+	blockIntervalID                                   string
 }
 
 // NewApplication initializes a new store if one is not already
@@ -53,14 +63,22 @@ type ChainlinkApplication struct {
 func NewApplication(config store.Config) Application {
 	store := store.NewStore(config)
 	ht := NewHeadTracker(store)
+	webhookNotifier := NewWebhookNotifier(store)
+	store.RunNotifier = webhookNotifier
+	jobSubscriber := NewJobSubscriber(store)
 	return &ChainlinkApplication{
+		BalanceMonitor:           NewBalanceMonitor(store, webhookNotifier),
 		HeadTracker:              ht,
-		JobSubscriber:            NewJobSubscriber(store),
+		LinkSweeper:              NewLinkSweeper(store),
+		JobSubscriber:            jobSubscriber,
 		JobRunner:                NewJobRunner(store),
 		Scheduler:                NewScheduler(store),
+		ServiceAgreementMonitor:  NewServiceAgreementMonitor(store, jobSubscriber),
 		Store:                    store,
 		SessionReaper:            NewStoreReaper(store),
 		BulkRunDeleter:           NewBulkRunDeleter(store),
+		DatabaseBackup:           NewDatabaseBackup(store),
+		WebhookNotifier:          webhookNotifier,
 		Exiter:                   os.Exit,
 		pendingConnectionResumer: newPendingConnectionResumer(store),
 	}
@@ -83,6 +101,10 @@ func (app *ChainlinkApplication) Start() error {
 	app.txManagerID = app.HeadTracker.Attach(app.Store.TxManager)
 	app.jobSubscriberID = app.HeadTracker.Attach(app.JobSubscriber)
 	app.connectionResumerID = app.HeadTracker.Attach(app.pendingConnectionResumer)
+	app.linkSweeperID = app.HeadTracker.Attach(app.LinkSweeper)
+	app.balanceMonitorID = app.HeadTracker.Attach(app.BalanceMonitor)
+	app.saMonitorID = app.HeadTracker.Attach(app.ServiceAgreementMonitor)
+	app.blockIntervalID = app.HeadTracker.Attach(app.Scheduler.Blocks)
 
 	return multierr.Combine(
 		app.Store.Start(),
@@ -98,6 +120,8 @@ func (app *ChainlinkApplication) Start() error {
 		app.Scheduler.Start(),
 		app.SessionReaper.Start(),
 		app.BulkRunDeleter.Start(),
+		app.DatabaseBackup.Start(),
+		app.WebhookNotifier.Start(),
 	)
 }
 
@@ -113,9 +137,15 @@ func (app *ChainlinkApplication) Stop() error {
 	app.JobRunner.Stop()
 	merr = multierr.Append(merr, app.SessionReaper.Stop())
 	merr = multierr.Append(merr, app.BulkRunDeleter.Stop())
+	merr = multierr.Append(merr, app.DatabaseBackup.Stop())
+	merr = multierr.Append(merr, app.WebhookNotifier.Stop())
 	app.HeadTracker.Detach(app.jobSubscriberID)
 	app.HeadTracker.Detach(app.txManagerID)
 	app.HeadTracker.Detach(app.connectionResumerID)
+	app.HeadTracker.Detach(app.linkSweeperID)
+	app.HeadTracker.Detach(app.balanceMonitorID)
+	app.HeadTracker.Detach(app.saMonitorID)
+	app.HeadTracker.Detach(app.blockIntervalID)
 	return multierr.Append(merr, app.Store.Close())
 }
 
@@ -134,6 +164,11 @@ func (app *ChainlinkApplication) WakeBulkRunDeleter() {
 	app.BulkRunDeleter.WakeUp()
 }
 
+// WakeWebhookNotifier wakes up the webhook notifier to deliver notifications.
+func (app *ChainlinkApplication) WakeWebhookNotifier() {
+	app.WebhookNotifier.WakeUp()
+}
+
 // AddJob adds a job to the store and the scheduler. If there was
 // an error from adding the job to the store, the job will not be
 // added to the scheduler.
@@ -147,6 +182,38 @@ func (app *ChainlinkApplication) AddJob(job models.JobSpec) error {
 	return app.JobSubscriber.AddJob(job, nil) // nil for latest
 }
 
+// ArchiveJob marks the job as archived and stops it from being run by
+// the scheduler or from ethereum logs. Its runs are kept.
+func (app *ChainlinkApplication) ArchiveJob(ID string) error {
+	job, err := app.Store.ArchiveJob(ID)
+	if err != nil {
+		return err
+	}
+
+	app.Scheduler.RemoveJob(job.ID)
+	if job.IsLogInitiated() {
+		// Not subscribed while disconnected from the ethereum node, and
+		// archived jobs are skipped when reconnecting.
+		_ = app.JobSubscriber.RemoveJob(job.ID)
+	}
+	return nil
+}
+
+// UpdateJob saves the job as its next version and replaces the scheduled
+// and ethereum log initiators of the previous version with its own.
+func (app *ChainlinkApplication) UpdateJob(job *models.JobSpec) error {
+	if err := app.Store.UpdateJob(job); err != nil {
+		return err
+	}
+
+	app.Scheduler.RemoveJob(job.ID)
+	app.Scheduler.AddJob(*job)
+	// The previous version may not have been log initiated, or may not be
+	// subscribed while disconnected from the ethereum node.
+	_ = app.JobSubscriber.RemoveJob(job.ID)
+	return app.JobSubscriber.AddJob(*job, nil) // nil for latest
+}
+
 // AddAdapter adds an adapter to the store. If another
 // adapter with the same name already exists the adapter
 // will not be added.
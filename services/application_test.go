@@ -46,6 +46,49 @@ func TestChainlinkApplication_AddJob(t *testing.T) {
 	app.AddJob(cltest.NewJob())
 }
 
+func TestChainlinkApplication_ArchiveJob(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	ctrl := gomock.NewController(t)
+	jobSubscriberMock := mock_services.NewMockJobSubscriber(ctrl)
+	app.ChainlinkApplication.JobSubscriber = jobSubscriberMock
+
+	j, _ := cltest.NewJobWithLogInitiator()
+	require.NoError(t, app.Store.SaveJob(&j))
+
+	jobSubscriberMock.EXPECT().RemoveJob(j.ID)
+	require.NoError(t, app.ArchiveJob(j.ID))
+
+	archived, err := app.Store.FindJob(j.ID)
+	require.NoError(t, err)
+	assert.True(t, archived.Archived())
+}
+
+func TestChainlinkApplication_UpdateJob(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	ctrl := gomock.NewController(t)
+	jobSubscriberMock := mock_services.NewMockJobSubscriber(ctrl)
+	app.ChainlinkApplication.JobSubscriber = jobSubscriberMock
+
+	j, _ := cltest.NewJobWithLogInitiator()
+	require.NoError(t, app.Store.SaveJob(&j))
+
+	next, err := j.NewVersion([]byte(`{"name":"renamed"}`))
+	require.NoError(t, err)
+
+	gomock.InOrder(
+		jobSubscriberMock.EXPECT().RemoveJob(j.ID),
+		jobSubscriberMock.EXPECT().AddJob(gomock.Any(), gomock.Any()),
+	)
+	require.NoError(t, app.UpdateJob(&next))
+
+	updated, err := app.Store.FindJob(j.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), updated.Version)
+	assert.Equal(t, "renamed", updated.Name)
+}
+
 func TestChainlinkApplication_resumesPendingConnection(t *testing.T) {
 	app, cleanup := cltest.NewApplication()
 	defer cleanup()
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// BalanceMonitor checks the ETH balance of the node's accounts on every new
+// head, alerting when an account falls below ETH_BALANCE_THRESHOLD and
+// resuming runs held in pending_funds whenever every account is funded, or
+// transactions are no longer held.
+//
+// Balances are checked in the background, so that the RPC calls do not hold
+// up the HeadTracker.
+type BalanceMonitor struct {
+	store    *store.Store
+	notifier WebhookNotifier
+	checker  SleeperTask
+}
+
+// NewBalanceMonitor returns a BalanceMonitor that alerts through the
+// notifier's webhooks.
+func NewBalanceMonitor(store *store.Store, notifier WebhookNotifier) *BalanceMonitor {
+	bm := &BalanceMonitor{store: store, notifier: notifier}
+	bm.checker = NewSleeperTask(balanceChecker{bm})
+	return bm
+}
+
+// Connect starts checking balances in the background, checking them as soon
+// as the node connects.
+func (bm *BalanceMonitor) Connect(*models.IndexableBlockNumber) error {
+	if err := bm.checker.Start(); err != nil {
+		return err
+	}
+	bm.checker.WakeUp()
+	return nil
+}
+
+// Disconnect stops checking balances.
+func (bm *BalanceMonitor) Disconnect() {
+	if err := bm.checker.Stop(); err != nil {
+		logger.Errorw("Unable to stop balance checks", "error", err)
+	}
+}
+
+// OnNewHead asks for the balances to be checked.
+func (bm *BalanceMonitor) OnNewHead(*models.BlockHeader) {
+	bm.checker.WakeUp()
+}
+
+// CheckBalances updates the balance of every account, alerting on those
+// that have become low and resuming held runs when no account is low or
+// ETH_TX_HOLD_ON_LOW_BALANCE is disabled.
+func (bm *BalanceMonitor) CheckBalances() {
+	accounts, err := bm.store.Signer.SigningAccounts()
+	if err != nil {
+		logger.Errorw("Unable to list accounts to check balances", "error", err)
+		return
+	}
+
+	addresses := make([]common.Address, len(accounts))
+	for i, account := range accounts {
+		addresses[i] = account.Address
+	}
+	bm.store.Balances.Retain(addresses)
+
+	threshold := bm.store.Config.EthBalanceThreshold()
+	for _, account := range accounts {
+		balance, err := bm.store.TxManager.GetEthBalance(account.Address)
+		if err != nil {
+			logger.Errorw("Unable to check ETH balance", "address", account.Address.Hex(), "error", err)
+			continue
+		}
+
+		low, changed := bm.store.Balances.Update(account.Address, balance, threshold)
+		if low && changed {
+			logger.Warnw(
+				fmt.Sprintf("ETH balance of %s is below %v, fund it to keep sending transactions", account.Address.Hex(), threshold),
+				"address", account.Address.Hex(),
+				"balance", balance,
+			)
+			bm.notifier.NotifyAccount(models.WebhookAccountPayload{
+				Event:     models.WebhookEventAccountLowBalance,
+				Address:   account.Address,
+				Balance:   balance,
+				Threshold: threshold,
+			})
+		} else if changed {
+			logger.Infow(fmt.Sprintf("ETH balance of %s has been topped up", account.Address.Hex()), "balance", balance)
+		}
+	}
+
+	if bm.store.Balances.Funded() || !bm.store.Config.EthTxHoldOnLowBalance() {
+		if err := resumeFundedRuns(bm.store); err != nil {
+			logger.Errorw("Unable to resume runs pending funds", "error", err)
+		}
+	}
+}
+
+// resumeFundedRuns resumes every run held in pending_funds.
+func resumeFundedRuns(store *store.Store) error {
+	runs, err := store.JobRunsWithStatus(models.RunStatusPendingFunds)
+	if err != nil {
+		return err
+	}
+	for _, run := range runs {
+		if _, err := ResumeFundedTask(&run, store); err != nil {
+			logger.Errorw("Unable to resume run pending funds", "run", run.ID, "error", err)
+		}
+	}
+	return nil
+}
+
+type balanceChecker struct {
+	monitor *BalanceMonitor
+}
+
+func (bc balanceChecker) Work() {
+	bc.monitor.CheckBalances()
+}
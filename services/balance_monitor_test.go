@@ -0,0 +1,133 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/onsi/gomega"
+	"github.com/smartcontractkit/chainlink/adapters"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/internal/mocks"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceMonitor_CheckBalances(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("ETH_BALANCE_THRESHOLD", "100")
+	store.Config.Set("ETH_TX_HOLD_ON_LOW_BALANCE", true)
+	account, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	txm := mocks.NewMockTxManager(ctrl)
+	store.TxManager = txm
+
+	webhook, err := models.NewWebhookFromRequest(models.WebhookRequest{
+		URL:    cltest.WebURL("http://localhost:6688/alerts"),
+		Events: []models.WebhookEvent{models.WebhookEventAccountLowBalance},
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.SaveWebhook(&webhook))
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	run := job.NewRun(initiator)
+	run.Status = models.RunStatusPendingFunds
+	run.TaskRuns = []models.TaskRun{models.TaskRun{ID: "tr1", Task: models.TaskSpec{Type: adapters.TaskTypeNoOp}}}
+	require.NoError(t, store.SaveJobRun(&run))
+
+	monitor := services.NewBalanceMonitor(store, services.NewWebhookNotifier(store))
+
+	txm.EXPECT().GetEthBalance(account.Address).Return(assets.NewEth(99), nil).Times(2)
+	monitor.CheckBalances()
+	monitor.CheckBalances()
+
+	assert.False(t, store.Balances.Funded())
+	assert.Equal(t, assets.NewEth(99), store.Balances.Balance(account.Address))
+	deliveries, _, err := store.WebhookDeliveriesFor(webhook.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 1, "expected a single alert while the balance stays low")
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingFunds, run.Status)
+
+	txm.EXPECT().GetEthBalance(account.Address).Return(assets.NewEth(100), nil)
+	monitor.CheckBalances()
+
+	assert.True(t, store.Balances.Funded())
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusInProgress, run.Status)
+
+	held := cltest.CreateJobRunWithStatus(store, job, models.RunStatusPendingFunds)
+	txm.EXPECT().GetEthBalance(account.Address).Return(assets.NewEth(100), nil)
+	monitor.CheckBalances()
+
+	held, err = store.FindJobRun(held.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusInProgress, held.Status, "expected runs held while funded to be resumed")
+}
+
+func TestBalanceMonitor_CheckBalances_HoldDisabled(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("ETH_BALANCE_THRESHOLD", "100")
+	store.Config.Set("ETH_TX_HOLD_ON_LOW_BALANCE", false)
+	account, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	txm := mocks.NewMockTxManager(ctrl)
+	store.TxManager = txm
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	run := cltest.CreateJobRunWithStatus(store, job, models.RunStatusPendingFunds)
+
+	monitor := services.NewBalanceMonitor(store, services.NewWebhookNotifier(store))
+	txm.EXPECT().GetEthBalance(account.Address).Return(assets.NewEth(99), nil)
+	monitor.CheckBalances()
+
+	assert.False(t, store.Balances.Funded())
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusInProgress, run.Status)
+}
+
+func TestBalanceMonitor_OnNewHead(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("ETH_BALANCE_THRESHOLD", "100")
+	account, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	txm := mocks.NewMockTxManager(ctrl)
+	store.TxManager = txm
+
+	monitor := services.NewBalanceMonitor(store, services.NewWebhookNotifier(store))
+	txm.EXPECT().GetEthBalance(account.Address).Return(assets.NewEth(99), nil).AnyTimes()
+	require.NoError(t, monitor.Connect(nil))
+	defer monitor.Disconnect()
+
+	monitor.OnNewHead(cltest.NewBlockHeader(1))
+	gomega.NewGomegaWithT(t).Eventually(func() bool {
+		return store.Balances.Balance(account.Address) != nil
+	}).Should(gomega.BeTrue())
+	assert.Equal(t, assets.NewEth(99), store.Balances.Balance(account.Address))
+}
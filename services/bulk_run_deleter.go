@@ -1,9 +1,6 @@
 package services
 
 import (
-	"fmt"
-
-	"github.com/asdine/storm/q"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
@@ -22,54 +19,113 @@ type bulkRunDeleter struct {
 }
 
 func (btr *bulkRunDeleter) Work() {
-	query := btr.store.ORM.DB.Select(q.Eq("Status", models.BulkTaskStatusInProgress)).OrderBy("CreatedAt")
-	err := query.Each(&models.BulkDeleteRunTask{}, func(r interface{}) error {
-		task := r.(*models.BulkDeleteRunTask)
+	tasks, err := btr.store.BulkDeleteRunTasksWithStatus(models.BulkTaskStatusInProgress)
+	if err != nil {
+		logger.Errorw("Error querying bulk tasks", "error", err)
+		return
+	}
+
+	for i := range tasks {
+		task := &tasks[i]
 		logger.Infow("Processing bulk run delete task",
 			"task_id", task.ID,
 			"statuses", task.Query.Status,
 			"updated_before", task.Query.UpdatedBefore,
+			"completed_before", task.Query.CompletedBefore,
+			"job_spec_ids", task.Query.JobSpecIDs,
+			"initiator_types", task.Query.InitiatorTypes,
 		)
 
-		err := RunPendingTask(btr.store.ORM, task)
-		if err != nil {
+		if err := RunPendingTask(btr.store.ORM, task); err != nil {
 			logger.Errorw("Error deleting runs for bulk task", "task_id", task.ID, "error", err)
+			return
 		}
-		return err
-	})
-	if err != nil && err != orm.ErrorNotFound {
-		logger.Errorw("Error querying bulk tasks", "error", err)
 	}
 }
 
-// RunPendingTask executes bulk run tasks
+// bulkDeleteBatchSize is the number of runs checked against a bulk delete
+// query before its progress is reported.
+const bulkDeleteBatchSize = 100
+
+// RunPendingTask executes bulk run tasks, saving the number of runs scanned
+// and deleted, and the last run checked, after each batch. A task that was
+// interrupted resumes after the last run it checked. It stops early, without
+// error, if the task is cancelled, including when it is cancelled after the
+// last batch.
 func RunPendingTask(orm *orm.ORM, task *models.BulkDeleteRunTask) error {
-	err := DeleteJobRuns(orm, &task.Query)
-	if err != nil {
-		task.Error = err
-		task.Status = models.BulkTaskStatusErrored
-	} else {
-		task.Status = models.BulkTaskStatusCompleted
+	err := DeleteJobRunsWithProgress(orm, &task.Query, task.LastRunID, func(scanned, deleted uint64, lastID string) error {
+		task.Scanned += scanned
+		task.Deleted += deleted
+		task.LastRunID = lastID
+		return orm.SaveBulkDeleteRunTaskProgress(task)
+	})
+	if task.Status != models.BulkTaskStatusCancelled {
+		if err != nil {
+			task.Error = err.Error()
+			task.Status = models.BulkTaskStatusErrored
+		} else {
+			task.Status = models.BulkTaskStatusCompleted
+		}
+		err = orm.SaveBulkDeleteRunTaskProgress(task)
+	}
+	if task.Status == models.BulkTaskStatusCancelled {
+		logger.Infow("Bulk run delete task cancelled", "task_id", task.ID, "deleted", task.Deleted)
+		return nil
 	}
-	return orm.DB.Save(task)
+	return err
 }
 
 // DeleteJobRuns removes runs that match a query
 func DeleteJobRuns(orm *orm.ORM, bulkQuery *models.BulkDeleteRunRequest) error {
-	query := orm.DB.Select(
-		q.And(
-			q.In("Status", bulkQuery.Status),
-			q.Lt("UpdatedAt", bulkQuery.UpdatedBefore),
-		),
-	).OrderBy("CompletedAt")
+	return DeleteJobRunsWithProgress(orm, bulkQuery, "", func(uint64, uint64, string) error { return nil })
+}
 
-	return query.Each(&models.JobRun{}, func(r interface{}) error {
-		run := r.(*models.JobRun)
-		logger.Debugw("Deleting run", "run_id", run.ID, "status", run.Status, "updated_at", run.UpdatedAt)
-		err := orm.DeleteStruct(run)
-		if err != nil {
-			return fmt.Errorf("error deleting run %s: %+v", run.ID, err)
-		}
+type bulkDeleteCandidate struct {
+	id      string
+	matches bool
+}
+
+// DeleteJobRunsWithProgress removes runs that match a query. The runs with
+// one of the query's statuses and an ID after the passed one are collected
+// in a single pass, in ID order, and then deleted in batches. progress is
+// called after each batch with the number of runs it scanned and deleted,
+// and the ID of its last run. An error from progress stops the deletion.
+func DeleteJobRunsWithProgress(
+	orm *orm.ORM,
+	bulkQuery *models.BulkDeleteRunRequest,
+	after string,
+	progress func(scanned, deleted uint64, lastID string) error,
+) error {
+	candidates := []bulkDeleteCandidate{}
+	err := orm.BulkDeleteRunCandidates(bulkQuery, after, func(run *models.JobRun) error {
+		candidates = append(candidates, bulkDeleteCandidate{id: run.ID, matches: bulkQuery.Matches(*run)})
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(candidates); start += bulkDeleteBatchSize {
+		end := start + bulkDeleteBatchSize
+		if end > len(candidates) {
+			end = len(candidates)
+		}
+		batch := candidates[start:end]
+
+		ids := []string{}
+		for _, candidate := range batch {
+			if candidate.matches {
+				ids = append(ids, candidate.id)
+			}
+		}
+		if err := orm.DeleteJobRuns(ids); err != nil {
+			return err
+		}
+
+		lastID := batch[len(batch)-1].id
+		if err := progress(uint64(len(batch)), uint64(len(ids)), lastID); err != nil {
+			return err
+		}
+	}
+	return nil
 }
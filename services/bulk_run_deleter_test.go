@@ -2,11 +2,14 @@ package services_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/services"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v3"
 )
 
 func TestDeleteJobRuns(t *testing.T) {
@@ -57,7 +60,7 @@ func TestRunPendingTask(t *testing.T) {
 	store, cleanup := cltest.NewStore()
 	defer cleanup()
 
-	task, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{})
+	task, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{UpdatedBefore: time.Now()})
 	assert.NoError(t, err)
 	err = services.RunPendingTask(store.ORM, task)
 	assert.NoError(t, err)
@@ -69,10 +72,191 @@ func TestRunPendingTask_Error(t *testing.T) {
 	// Close store immediately to trigger error
 	cleanup()
 
-	task, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{})
+	task, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{UpdatedBefore: time.Now()})
 	assert.NoError(t, err)
 	err = services.RunPendingTask(store.ORM, task)
 	assert.Error(t, err)
 	assert.Equal(t, string(models.BulkTaskStatusErrored), string(task.Status))
-	assert.NotNil(t, task.Error)
+	assert.NotEmpty(t, task.Error)
+}
+
+func TestDeleteJobRuns_Filters(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	otherJob, otherInitiator := cltest.NewJobWithWebInitiator()
+	otherInitiator.Type = models.InitiatorRunLog
+
+	completedRun := func(job models.JobSpec, initiator models.Initiator, completedAt string) models.JobRun {
+		run := job.NewRun(initiator)
+		run.Status = models.RunStatusCompleted
+		run.CompletedAt = null.TimeFrom(cltest.ParseISO8601(completedAt))
+		require.NoError(t, store.ORM.DB.Save(&run))
+		return run
+	}
+	oldRun := completedRun(job, initiator, "2018-01-01T00:00:00Z")
+	newRun := completedRun(job, initiator, "2018-01-30T00:00:00Z")
+	otherJobRun := completedRun(otherJob, initiator, "2018-01-01T00:00:00Z")
+	otherInitiatorRun := completedRun(job, otherInitiator, "2018-01-01T00:00:00Z")
+
+	err := services.DeleteJobRuns(store.ORM, &models.BulkDeleteRunRequest{
+		Status:          []models.RunStatus{models.RunStatusCompleted},
+		CompletedBefore: cltest.ParseISO8601("2018-01-15T00:00:00Z"),
+		JobSpecIDs:      []string{job.ID},
+		InitiatorTypes:  []string{models.InitiatorWeb},
+	})
+	require.NoError(t, err)
+
+	_, err = store.FindJobRun(oldRun.ID)
+	assert.Error(t, err)
+	for _, run := range []models.JobRun{newRun, otherJobRun, otherInitiatorRun} {
+		_, err = store.FindJobRun(run.ID)
+		assert.NoError(t, err)
+	}
+}
+
+func TestDeleteJobRunsWithProgress(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	for i, status := range []models.RunStatus{
+		models.RunStatusCompleted,
+		models.RunStatusCompleted,
+		models.RunStatusErrored,
+		models.RunStatusInProgress,
+	} {
+		run := job.NewRun(initiator)
+		run.Status = status
+		run.UpdatedAt = cltest.ParseISO8601("2018-01-01T00:00:00Z")
+		if i == 1 {
+			run.UpdatedAt = cltest.ParseISO8601("2018-01-30T00:00:00Z")
+		}
+		require.NoError(t, store.ORM.DB.Save(&run))
+	}
+
+	var scanned, deleted uint64
+	err := services.DeleteJobRunsWithProgress(store.ORM, &models.BulkDeleteRunRequest{
+		Status:        []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored},
+		UpdatedBefore: cltest.ParseISO8601("2018-01-15T00:00:00Z"),
+	}, "", func(s, d uint64, _ string) error {
+		scanned += s
+		deleted += d
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), scanned)
+	assert.Equal(t, uint64(2), deleted)
+
+	runCount, err := store.ORM.DB.Count(&models.JobRun{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, runCount)
+}
+
+func TestRunPendingTask_Progress(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	run := job.NewRun(initiator)
+	run.Status = models.RunStatusCompleted
+	require.NoError(t, store.ORM.DB.Save(&run))
+
+	task, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{
+		Status:        []models.RunStatus{models.RunStatusCompleted},
+		UpdatedBefore: time.Now(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBulkDeleteRunTask(task))
+
+	require.NoError(t, services.RunPendingTask(store.ORM, task))
+	found, err := store.FindBulkDeleteRunTask(task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkTaskStatusCompleted, found.Status)
+	assert.Equal(t, uint64(1), found.Scanned)
+	assert.Equal(t, uint64(1), found.Deleted)
+	assert.Empty(t, found.Error)
+}
+
+func TestRunPendingTask_Resumes(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	runs := []models.JobRun{job.NewRun(initiator), job.NewRun(initiator)}
+	for i := range runs {
+		runs[i].Status = models.RunStatusCompleted
+		require.NoError(t, store.ORM.DB.Save(&runs[i]))
+	}
+	checked, remaining := runs[0], runs[1]
+	if remaining.ID < checked.ID {
+		checked, remaining = remaining, checked
+	}
+
+	task, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{
+		Status:        []models.RunStatus{models.RunStatusCompleted},
+		UpdatedBefore: time.Now(),
+	})
+	require.NoError(t, err)
+	task.Scanned, task.Deleted, task.LastRunID = 1, 0, checked.ID
+	require.NoError(t, store.SaveBulkDeleteRunTask(task))
+
+	require.NoError(t, services.RunPendingTask(store.ORM, task))
+	found, err := store.FindBulkDeleteRunTask(task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkTaskStatusCompleted, found.Status)
+	assert.Equal(t, uint64(2), found.Scanned)
+	assert.Equal(t, uint64(1), found.Deleted)
+	assert.Equal(t, remaining.ID, found.LastRunID)
+
+	_, err = store.FindJobRun(checked.ID)
+	assert.NoError(t, err, "expected runs checked before the task was interrupted to be skipped")
+	_, err = store.FindJobRun(remaining.ID)
+	assert.Error(t, err)
+}
+
+func TestRunPendingTask_Cancelled(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	run := job.NewRun(initiator)
+	run.Status = models.RunStatusCompleted
+	require.NoError(t, store.ORM.DB.Save(&run))
+
+	task, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{
+		Status:        []models.RunStatus{models.RunStatusCompleted},
+		UpdatedBefore: time.Now(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBulkDeleteRunTask(task))
+	_, err = store.CancelBulkDeleteRunTask(task.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, services.RunPendingTask(store.ORM, task))
+	assert.Equal(t, models.BulkTaskStatusCancelled, task.Status)
+	found, err := store.FindBulkDeleteRunTask(task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkTaskStatusCancelled, found.Status)
+}
+
+func TestRunPendingTask_CancelledAfterLastBatch(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	task, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{
+		Status:        []models.RunStatus{models.RunStatusCompleted},
+		UpdatedBefore: time.Now(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBulkDeleteRunTask(task))
+	_, err = store.CancelBulkDeleteRunTask(task.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, services.RunPendingTask(store.ORM, task))
+	assert.Equal(t, models.BulkTaskStatusCancelled, task.Status)
+	found, err := store.FindBulkDeleteRunTask(task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkTaskStatusCancelled, found.Status, "expected the cancel not to be overwritten")
 }
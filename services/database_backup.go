@@ -0,0 +1,141 @@
+package services
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"go.uber.org/multierr"
+)
+
+const (
+	backupPrefix     = "db-"
+	backupExtension  = ".bolt"
+	backupTimeFormat = "20060102T150405Z"
+)
+
+type databaseBackup struct {
+	store *store.Store
+}
+
+// NewDatabaseBackup creates a task that writes a backup of the database to
+// BackupDir every BackupInterval, keeping the BackupRetention most recent.
+func NewDatabaseBackup(store *store.Store) SleeperTask {
+	return &periodicSleeperTask{
+		SleeperTask: NewSleeperTask(&databaseBackup{store: store}),
+		interval:    store.Config.BackupInterval(),
+	}
+}
+
+func (db *databaseBackup) Work() {
+	config := db.store.Config
+	dir := config.BackupDir()
+	path, err := WriteDatabaseBackup(db.store.ORM, dir, config.BackupCompress(), db.store.Clock.Now())
+	if err != nil {
+		logger.Errorw("Unable to back up database", "dir", dir, "error", err)
+		return
+	}
+	logger.Infow("Backed up database", "path", path)
+
+	if err := RotateDatabaseBackups(dir, config.BackupRetention()); err != nil {
+		logger.Errorw("Unable to remove old database backups", "dir", dir, "error", err)
+	}
+}
+
+// WriteDatabaseBackup writes a snapshot of the database to a file in dir
+// named after the time given, gzip compressed if asked to, and returns its
+// path. The file only appears under its final name once it is complete.
+func WriteDatabaseBackup(orm *orm.ORM, dir string, compress bool, now time.Time) (string, error) {
+	if err := os.MkdirAll(dir, os.FileMode(0700)); err != nil {
+		return "", err
+	}
+
+	name := backupPrefix + now.UTC().Format(backupTimeFormat) + backupExtension
+	if compress {
+		name += ".gz"
+	}
+	path := filepath.Join(dir, name)
+	tmp := path + ".tmp"
+
+	if err := writeBackupFile(orm, tmp, compress); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	return path, os.Rename(tmp, path)
+}
+
+func writeBackupFile(orm *orm.ORM, path string, compress bool) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if compress {
+		gz := gzip.NewWriter(file)
+		if _, err := orm.WriteBackup(gz); err != nil {
+			return err
+		} else if err := gz.Close(); err != nil {
+			return err
+		}
+	} else if _, err := orm.WriteBackup(file); err != nil {
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// DatabaseBackups returns the paths of the backups in dir, oldest first.
+func DatabaseBackups(dir string) ([]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	backups := []string{}
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasPrefix(name, backupPrefix) {
+			continue
+		}
+		if strings.HasSuffix(name, backupExtension) || strings.HasSuffix(name, backupExtension+".gz") {
+			backups = append(backups, filepath.Join(dir, name))
+		}
+	}
+	sort.Strings(backups)
+	return backups, nil
+}
+
+// RotateDatabaseBackups removes all but the keep most recent backups in dir.
+// Zero keeps every backup.
+func RotateDatabaseBackups(dir string, keep uint64) error {
+	if keep == 0 {
+		return nil
+	}
+	backups, err := DatabaseBackups(dir)
+	if err != nil {
+		return err
+	}
+	if uint64(len(backups)) <= keep {
+		return nil
+	}
+
+	var merr error
+	for _, path := range backups[:uint64(len(backups))-keep] {
+		logger.Debugw("Removing database backup", "path", path)
+		merr = multierr.Append(merr, os.Remove(path))
+	}
+	return merr
+}
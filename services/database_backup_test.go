@@ -0,0 +1,130 @@
+package services_test
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openBackup opens the database in the backup, decompressing it first if
+// needed.
+func openBackup(t *testing.T, path string) *orm.ORM {
+	if strings.HasSuffix(path, ".gz") {
+		in, err := os.Open(path)
+		require.NoError(t, err)
+		defer in.Close()
+		gz, err := gzip.NewReader(in)
+		require.NoError(t, err)
+		out, err := os.Create(strings.TrimSuffix(path, ".gz"))
+		require.NoError(t, err)
+		defer out.Close()
+		_, err = io.Copy(out, gz)
+		require.NoError(t, err)
+		path = out.Name()
+	}
+	db, err := orm.NewORM(path, time.Second)
+	require.NoError(t, err)
+	return db
+}
+
+func TestWriteDatabaseBackup(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	now := cltest.ParseISO8601("2018-10-18T12:30:00Z")
+
+	tests := []struct {
+		name     string
+		compress bool
+		filename string
+	}{
+		{"uncompressed", false, "db-20181018T123000Z.bolt"},
+		{"compressed", true, "db-20181018T123000Z.bolt.gz"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			dir := filepath.Join(store.Config.RootDir(), test.name)
+			path, err := services.WriteDatabaseBackup(store.ORM, dir, test.compress, now)
+			require.NoError(t, err)
+			assert.Equal(t, filepath.Join(dir, test.filename), path)
+
+			backup := openBackup(t, path)
+			defer backup.Close()
+			found, err := backup.FindJob(job.ID)
+			require.NoError(t, err)
+			assert.Equal(t, job.ID, found.ID)
+		})
+	}
+}
+
+func TestRotateDatabaseBackups(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	dir := filepath.Join(store.Config.RootDir(), "backups")
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	for _, name := range []string{
+		"db-20181016T000000Z.bolt.gz",
+		"db-20181017T000000Z.bolt",
+		"db-20181018T000000Z.bolt.gz",
+		"db-20181018T120000Z.bolt.tmp",
+		"notes.txt",
+	} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte{}, 0600))
+	}
+
+	require.NoError(t, services.RotateDatabaseBackups(dir, 0))
+	backups, err := services.DatabaseBackups(dir)
+	require.NoError(t, err)
+	assert.Len(t, backups, 3)
+
+	require.NoError(t, services.RotateDatabaseBackups(dir, 2))
+	backups, err = services.DatabaseBackups(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "db-20181017T000000Z.bolt"),
+		filepath.Join(dir, "db-20181018T000000Z.bolt.gz"),
+	}, backups)
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 4)
+}
+
+func TestDatabaseBackup(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	dir := filepath.Join(store.Config.RootDir(), "scheduled")
+	store.Config.Set("BACKUP_DIR", dir)
+	store.Config.Set("BACKUP_INTERVAL", "10ms")
+	store.Config.Set("BACKUP_RETENTION", "1")
+
+	backup := services.NewDatabaseBackup(store)
+	require.NoError(t, backup.Start())
+	defer backup.Stop()
+
+	gomega.NewGomegaWithT(t).Eventually(func() []string {
+		backups, err := services.DatabaseBackups(dir)
+		assert.NoError(t, err)
+		return backups
+	}).Should(gomega.HaveLen(1))
+}
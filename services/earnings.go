@@ -0,0 +1,93 @@
+package services
+
+import (
+	"sort"
+
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+)
+
+// NewRunEarnings returns the LINK earned by a run alongside the gas spent by
+// its transactions. Only completed runs count as earning their payment, but
+// gas is counted for every run since it is spent regardless of the outcome.
+func NewRunEarnings(orm *orm.ORM, run models.JobRun) (presenters.RunEarnings, error) {
+	gas, err := GasForRun(orm, run)
+	if err != nil {
+		return presenters.RunEarnings{}, err
+	}
+
+	earned := assets.NewLink(0)
+	if payment := runPayment(run); run.Status.Completed() && payment != nil {
+		earned.Set(payment)
+	}
+
+	return presenters.RunEarnings{
+		RunID:      run.ID,
+		Status:     string(run.Status),
+		LinkEarned: earned,
+		GasUsed:    gas.GasUsed,
+		GasCost:    gas.GasCost,
+	}, nil
+}
+
+// JobEarnings returns the earnings of each run of the job along with the
+// job's totals.
+func JobEarnings(orm *orm.ORM, jobID string) (presenters.JobEarnings, error) {
+	je := presenters.NewJobEarnings(jobID)
+	je.Runs = []presenters.RunEarnings{}
+
+	runs, err := orm.JobRunsFor(jobID)
+	if err != nil {
+		return je, err
+	}
+	for _, run := range runs {
+		re, err := NewRunEarnings(orm, run)
+		if err != nil {
+			return je, err
+		}
+		je.Add(re)
+		je.Runs = append(je.Runs, re)
+	}
+	return je, nil
+}
+
+// EarningsSummary totals the earnings of every job on the node. Runs are
+// read in batches so the whole table is never held in memory.
+func EarningsSummary(orm *orm.ORM) (presenters.EarningsSummary, error) {
+	jobs := map[string]*presenters.JobEarnings{}
+
+	var merr error
+	err := orm.AllInBatches(&[]models.JobRun{}, func(run models.JobRun) bool {
+		re, err := NewRunEarnings(orm, run)
+		if err != nil {
+			merr = err
+			return false
+		}
+		je, ok := jobs[run.JobID]
+		if !ok {
+			earnings := presenters.NewJobEarnings(run.JobID)
+			je = &earnings
+			jobs[run.JobID] = je
+		}
+		je.Add(re)
+		return true
+	})
+	summary := presenters.NewEarningsSummary()
+	if err != nil {
+		return summary, err
+	} else if merr != nil {
+		return summary, merr
+	}
+
+	jobIDs := make([]string, 0, len(jobs))
+	for id := range jobs {
+		jobIDs = append(jobIDs, id)
+	}
+	sort.Strings(jobIDs)
+	for _, id := range jobIDs {
+		summary.Add(*jobs[id])
+	}
+	return summary, nil
+}
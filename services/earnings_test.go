@@ -0,0 +1,90 @@
+package services_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRunEarnings(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	hash := common.HexToHash("0x1")
+	require.NoError(t, store.Save(&models.TxAttempt{Hash: hash, GasPrice: big.NewInt(10)}))
+	receipts := `{"ethereumReceipts":[{"transactionHash":"` + hash.Hex() + `","gasUsed":"0x64"}]}`
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	tests := []struct {
+		name       string
+		status     models.RunStatus
+		wantEarned *assets.Link
+	}{
+		{"completed", models.RunStatusCompleted, assets.NewLink(7)},
+		{"errored", models.RunStatusErrored, assets.NewLink(0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			run := job.NewRun(initr)
+			run.Status = test.status
+			run.Result = cltest.RunResultWithData(receipts)
+			run.Result.Amount = assets.NewLink(7)
+
+			re, err := services.NewRunEarnings(store.ORM, run)
+			require.NoError(t, err)
+			assert.Equal(t, run.ID, re.RunID)
+			assert.Equal(t, test.wantEarned, re.LinkEarned)
+			assert.Equal(t, big.NewInt(100), re.GasUsed)
+			assert.Equal(t, assets.NewEth(1000), re.GasCost)
+		})
+	}
+}
+
+func TestJobEarningsAndEarningsSummary(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	j1, i1 := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&j1))
+	j2, i2 := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&j2))
+
+	for _, amount := range []int64{2, 3} {
+		run := j1.NewRun(i1)
+		run.Status = models.RunStatusCompleted
+		run.Result.Amount = assets.NewLink(amount)
+		require.NoError(t, store.SaveJobRun(&run))
+	}
+	run := j2.NewRun(i2)
+	run.Status = models.RunStatusCompleted
+	run.Overrides.Amount = assets.NewLink(10)
+	require.NoError(t, store.SaveJobRun(&run))
+
+	je, err := services.JobEarnings(store.ORM, j1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, j1.ID, je.JobID)
+	assert.Equal(t, 2, je.RunCount)
+	assert.Len(t, je.Runs, 2)
+	assert.Equal(t, assets.NewLink(5), je.LinkEarned)
+
+	summary, err := services.EarningsSummary(store.ORM)
+	require.NoError(t, err)
+	assert.Equal(t, 3, summary.RunCount)
+	assert.Equal(t, assets.NewLink(15), summary.LinkEarned)
+	require.Len(t, summary.Jobs, 2)
+	for _, je := range summary.Jobs {
+		assert.Empty(t, je.Runs)
+	}
+}
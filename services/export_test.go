@@ -1,6 +1,8 @@
 package services
 
 import (
+	"time"
+
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
 )
@@ -34,3 +36,15 @@ func ExportedNewPendingConnectionResumer(
 		resumer: resumer,
 	}
 }
+
+func ExportedNotifyPendingRuns(wn WebhookNotifier) {
+	wn.(*webhookNotifier).notifyPendingRuns()
+}
+
+func ExportedWebhookBackoff(attempts uint64) time.Duration {
+	return webhookBackoff(attempts)
+}
+
+func ExportedNewChainlinkCron() Cron {
+	return newChainlinkCron()
+}
@@ -151,6 +151,7 @@ func (ht *HeadTracker) disconnect() {
 }
 
 func (ht *HeadTracker) onNewHead(head *models.BlockHeader) {
+	ht.store.Events.PublishHead(*head)
 	ht.attachments.iter(func(t store.HeadTrackable) {
 		t.OnNewHead(head)
 	})
@@ -100,7 +100,14 @@ func (rm *jobRunner) resumeRunsSinceLastShutdown() error {
 	for _, run := range inProgressRuns {
 		rm.store.RunChannel.Send(run.ID)
 	}
-	return nil
+
+	// Balances are unknown until the BalanceMonitor's first check on connect,
+	// which resumes runs pending funds, so only resume them here when
+	// transactions are no longer held.
+	if rm.store.Config.EthTxHoldOnLowBalance() {
+		return nil
+	}
+	return resumeFundedRuns(rm.store)
 }
 
 func (rm *jobRunner) demultiplexRuns(starterWg *sync.WaitGroup) {
@@ -252,6 +259,9 @@ func executeRun(run *models.JobRun, store *store.Store) (*models.JobRun, error)
 		}
 	} else if !currentTaskRun.Status.Runnable() {
 		logger.Debugw("Task execution blocked", []interface{}{"run", run.ID, "task", currentTaskRun.ID, "state", currentTaskRun.Result.Status}...)
+	} else if result.Skipped {
+		logger.Debugw("Skipping remaining tasks", []interface{}{"run", run.ID, "task", currentTaskRun.ID}...)
+		run.TaskRuns = run.TaskRuns[:currentTaskRunIndex+1]
 	} else if run.TasksRemain() {
 		run = queueNextTask(run, store)
 	}
@@ -36,6 +36,10 @@ func TestJobRunner_resumeRunsSinceLastShutdown(t *testing.T) {
 	inProgressRun.Status = models.RunStatusInProgress
 	assert.NoError(t, store.SaveJobRun(&inProgressRun))
 
+	heldRun := j.NewRun(i)
+	heldRun.Status = models.RunStatusPendingFunds
+	assert.NoError(t, store.SaveJobRun(&heldRun))
+
 	assert.NoError(t, services.ExportedResumeRunsSinceLastShutdown(rm))
 	messages := []string{}
 
@@ -47,7 +51,11 @@ func TestJobRunner_resumeRunsSinceLastShutdown(t *testing.T) {
 	assert.True(t, open)
 	messages = append(messages, rr.ID)
 
-	expectedMessages := []string{sleepingRun.ID, inProgressRun.ID}
+	rr, open = <-store.RunChannel.Receive()
+	assert.True(t, open)
+	messages = append(messages, rr.ID)
+
+	expectedMessages := []string{sleepingRun.ID, inProgressRun.ID, heldRun.ID}
 	assert.ElementsMatch(t, expectedMessages, messages)
 }
 
@@ -143,3 +151,58 @@ func TestJobRunner_Stop(t *testing.T) {
 		return services.ExportedWorkerCount(rm)
 	}).Should(gomega.Equal(0))
 }
+
+func TestJobRunner_executeRun_DeviationSkipsRemainingTasks(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	now := cltest.ParseISO8601("2019-01-01T12:00:00.000Z")
+	cltest.UseSettableClock(store).SetTime(now)
+	rm, cleanup := cltest.NewJobRunner(store)
+	defer cleanup()
+	require.NoError(t, rm.Start())
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask("noop"),
+		cltest.NewTask("deviation", `{"threshold":1}`),
+		cltest.NewTask("noop"),
+	}
+	require.NoError(t, store.SaveJob(&j))
+
+	submitted := func() string {
+		ds, err := store.FindDeviationSubmission(j.ID)
+		if err != nil {
+			return ""
+		}
+		return ds.Value
+	}
+	execute := func(value string) models.JobRun {
+		input := models.RunResult{Data: cltest.JSONFromString(fmt.Sprintf(`{"value":"%v"}`, value))}
+		run, err := services.ExecuteJob(j, initr, input, nil, store)
+		require.NoError(t, err)
+		return cltest.WaitForJobRunToComplete(t, store, *run)
+	}
+
+	run := execute("100")
+	assert.False(t, run.Result.Skipped)
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[2].Status)
+	gomega.NewGomegaWithT(t).Eventually(submitted).Should(gomega.Equal("100"))
+
+	run = execute("100.5")
+	assert.True(t, run.Result.Skipped)
+	require.Len(t, run.TaskRuns, 2)
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[1].Status)
+	gomega.NewGomegaWithT(t).Consistently(submitted).Should(gomega.Equal("100"))
+
+	run = execute("102")
+	assert.False(t, run.Result.Skipped)
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[2].Status)
+	gomega.NewGomegaWithT(t).Eventually(submitted).Should(gomega.Equal("102"))
+
+	ds, err := store.FindDeviationSubmission(j.ID)
+	require.NoError(t, err)
+	assert.Equal(t, now.Unix(), ds.SubmittedAt.Unix())
+	assert.False(t, ds.InFlight())
+}
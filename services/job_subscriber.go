@@ -1,6 +1,7 @@
 package services
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/smartcontractkit/chainlink/logger"
@@ -14,6 +15,7 @@ import (
 type JobSubscriber interface {
 	store.HeadTrackable
 	AddJob(job models.JobSpec, bn *models.IndexableBlockNumber) error
+	RemoveJob(ID string) error
 	Jobs() []models.JobSpec
 }
 
@@ -44,6 +46,20 @@ func (js *jobSubscriber) AddJob(job models.JobSpec, bn *models.IndexableBlockNum
 	return nil
 }
 
+// RemoveJob unsubscribes the job with the passed ID from its ethereum logs.
+func (js *jobSubscriber) RemoveJob(ID string) error {
+	js.jobsMutex.Lock()
+	defer js.jobsMutex.Unlock()
+	for i, sub := range js.jobSubscriptions {
+		if sub.Job.ID == ID {
+			sub.Unsubscribe()
+			js.jobSubscriptions = append(js.jobSubscriptions[:i], js.jobSubscriptions[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("JobSubscriber#RemoveJob: job %s is not subscribed", ID)
+}
+
 // Jobs returns the jobs being listened to.
 func (js *jobSubscriber) Jobs() []models.JobSpec {
 	js.jobsMutex.RLock()
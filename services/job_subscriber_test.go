@@ -66,6 +66,27 @@ func TestJobSubscriber_reconnectLoop_Resubscribing(t *testing.T) {
 	eth.EventuallyAllCalled(t)
 }
 
+func TestJobSubscriber_RemoveJob(t *testing.T) {
+	t.Parallel()
+
+	store, el, cleanup := cltest.NewJobSubscriber()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(store)
+	j1, _ := cltest.NewJobWithLogInitiator()
+	j2, _ := cltest.NewJobWithLogInitiator()
+	eth.RegisterSubscription("logs")
+	eth.RegisterSubscription("logs")
+	assert.Nil(t, el.AddJob(j1, cltest.IndexableBlockNumber(1)))
+	assert.Nil(t, el.AddJob(j2, cltest.IndexableBlockNumber(1)))
+
+	assert.Nil(t, el.RemoveJob(j1.ID))
+	jobs := el.Jobs()
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, j2.ID, jobs[0].ID)
+	assert.Error(t, el.RemoveJob(j1.ID))
+	eth.EventuallyAllCalled(t)
+}
+
 func TestJobSubscriber_AttachedToHeadTracker(t *testing.T) {
 	t.Parallel()
 	g := gomega.NewGomegaWithT(t)
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+)
+
+// LinkSweeper withdraws LINK earned by the oracle contract to a cold
+// address. Every LINK_SWEEP_BLOCKS blocks it checks the contract's balance
+// and, once LINK_SWEEP_THRESHOLD is reached, withdraws everything above
+// LINK_SWEEP_RESERVE.
+//
+// Sweeps run in the background, so that the RPC calls do not hold up the
+// HeadTracker, and one at a time.
+type LinkSweeper struct {
+	store       *store.Store
+	sweeper     SleeperTask
+	lastChecked uint64
+	head        uint64
+	mutex       sync.Mutex
+}
+
+// NewLinkSweeper returns a LinkSweeper for the store.
+func NewLinkSweeper(store *store.Store) *LinkSweeper {
+	ls := &LinkSweeper{store: store}
+	ls.sweeper = NewSleeperTask(linkSweepWorker{ls})
+	return ls
+}
+
+// Connect starts sweeping in the background.
+func (ls *LinkSweeper) Connect(*models.IndexableBlockNumber) error {
+	return ls.sweeper.Start()
+}
+
+// Disconnect stops sweeping.
+func (ls *LinkSweeper) Disconnect() {
+	if err := ls.sweeper.Stop(); err != nil {
+		logger.Errorw("Unable to stop LINK sweeps", "error", err)
+	}
+}
+
+// OnNewHead asks for the oracle contract to be swept if enough blocks have
+// passed since it was last checked.
+func (ls *LinkSweeper) OnNewHead(head *models.BlockHeader) {
+	number := head.Number.ToInt().Uint64()
+	if ls.store.Config.LinkSweepAddress() == nil || !ls.due(number) {
+		return
+	}
+	ls.sweeper.WakeUp()
+}
+
+func (ls *LinkSweeper) due(number uint64) bool {
+	ls.mutex.Lock()
+	defer ls.mutex.Unlock()
+
+	interval := ls.store.Config.LinkSweepBlocks()
+	if ls.lastChecked != 0 && number < ls.lastChecked+interval {
+		return false
+	}
+	ls.lastChecked = number
+	ls.head = number
+	return true
+}
+
+func (ls *LinkSweeper) sweepAtHead() {
+	ls.mutex.Lock()
+	number := ls.head
+	ls.mutex.Unlock()
+
+	if sweep, err := ls.Sweep(number); err != nil {
+		logger.Errorw("Error sweeping LINK", "error", err)
+	} else if sweep != nil {
+		logger.Infow(
+			fmt.Sprintf("Swept %v LINK to %s", sweep.Amount, sweep.DestinationAddress.Hex()),
+			"txHash", sweep.TxHash.Hex(),
+		)
+	}
+}
+
+type linkSweepWorker struct {
+	sweeper *LinkSweeper
+}
+
+func (lsw linkSweepWorker) Work() {
+	lsw.sweeper.sweepAtHead()
+}
+
+// Sweep withdraws the oracle contract's LINK above the reserve to the sweep
+// address when its balance has reached the threshold, returning the record
+// of the sweep, or nil if nothing was withdrawn. Nothing is withdrawn while
+// the transaction of the last sweep is unconfirmed.
+func (ls *LinkSweeper) Sweep(blockNumber uint64) (*models.LinkSweep, error) {
+	config := ls.store.Config
+	destination := config.LinkSweepAddress()
+	if destination == nil {
+		return nil, errors.New("LinkSweepAddress not set; cannot sweep LINK")
+	}
+	contract := config.OracleContractAddress()
+	if contract == nil {
+		return nil, errors.New("OracleContractAddress not set; cannot sweep LINK")
+	}
+	if pending, err := ls.pendingSweep(); err != nil {
+		return nil, err
+	} else if pending != nil {
+		logger.Debugw("Last LINK sweep is unconfirmed, not sweeping", "txHash", pending.TxHash.Hex())
+		return nil, nil
+	}
+
+	wr := models.WithdrawalRequest{ContractAddress: *contract, DestinationAddress: *destination}
+	balance, err := ls.store.TxManager.ContractLINKBalance(wr)
+	if err != nil {
+		return nil, err
+	}
+	if balance.Cmp(config.LinkSweepThreshold()) < 0 {
+		return nil, nil
+	}
+	wr.Amount = assets.NewLink(0).Sub(&balance, config.LinkSweepReserve())
+	if wr.Amount.Cmp(assets.NewLink(0)) <= 0 {
+		return nil, nil
+	}
+
+	hash, err := ls.store.TxManager.WithdrawLINK(wr)
+	if err != nil {
+		return nil, err
+	}
+
+	sweep := models.NewLinkSweep()
+	sweep.ContractAddress = wr.ContractAddress
+	sweep.DestinationAddress = wr.DestinationAddress
+	sweep.Balance = &balance
+	sweep.Amount = wr.Amount
+	sweep.TxHash = hash
+	sweep.BlockNumber = blockNumber
+	return &sweep, ls.store.SaveLinkSweep(&sweep)
+}
+
+// pendingSweep returns the last sweep if its transaction is unconfirmed.
+func (ls *LinkSweeper) pendingSweep() (*models.LinkSweep, error) {
+	sweeps, _, err := ls.store.LinkSweeps(0, 1)
+	if err != nil || len(sweeps) == 0 {
+		return nil, err
+	}
+	last := sweeps[0]
+
+	txat, err := ls.store.FindTxAttempt(last.TxHash)
+	if err == orm.ErrorNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	tx, err := ls.store.FindTx(txat.TxID)
+	if err != nil {
+		return nil, err
+	} else if tx.Confirmed {
+		return nil, nil
+	}
+	return &last, nil
+}
@@ -0,0 +1,155 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/onsi/gomega"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/internal/mocks"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkSweeper_Sweep(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		balance int64
+		reserve string
+		want    *assets.Link
+	}{
+		{"below threshold", 99, "0", nil},
+		{"at threshold", 100, "0", assets.NewLink(100)},
+		{"leaves reserve", 150, "30", assets.NewLink(120)},
+		{"reserve exceeds balance", 150, "200", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store, cleanup := cltest.NewStore()
+			defer cleanup()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			txm := mocks.NewMockTxManager(ctrl)
+			store.TxManager = txm
+
+			oracle := cltest.NewAddress()
+			destination := cltest.NewAddress()
+			store.Config.Set("ORACLE_CONTRACT_ADDRESS", oracle.Hex())
+			store.Config.Set("LINK_SWEEP_ADDRESS", destination.Hex())
+			store.Config.Set("LINK_SWEEP_THRESHOLD", "100")
+			store.Config.Set("LINK_SWEEP_RESERVE", test.reserve)
+
+			txm.EXPECT().ContractLINKBalance(gomock.Any()).Return(*assets.NewLink(test.balance), nil)
+			hash := cltest.NewHash()
+			if test.want != nil {
+				txm.EXPECT().WithdrawLINK(models.WithdrawalRequest{
+					ContractAddress:    oracle,
+					DestinationAddress: destination,
+					Amount:             test.want,
+				}).Return(hash, nil)
+			}
+
+			sweep, err := services.NewLinkSweeper(store).Sweep(42)
+			require.NoError(t, err)
+
+			sweeps, count, err := store.LinkSweeps(0, 10)
+			require.NoError(t, err)
+			if test.want == nil {
+				assert.Nil(t, sweep)
+				assert.Equal(t, 0, count)
+				return
+			}
+
+			require.NotNil(t, sweep)
+			require.Len(t, sweeps, 1)
+			assert.Equal(t, 1, count)
+			assert.Equal(t, sweep.ID, sweeps[0].ID)
+			assert.Equal(t, hash, sweeps[0].TxHash)
+			assert.Equal(t, test.want, sweeps[0].Amount)
+			assert.Equal(t, assets.NewLink(test.balance), sweeps[0].Balance)
+			assert.Equal(t, uint64(42), sweeps[0].BlockNumber)
+		})
+	}
+}
+
+func TestLinkSweeper_Sweep_NotConfigured(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	_, err := services.NewLinkSweeper(store).Sweep(1)
+	assert.Error(t, err)
+
+	store.Config.Set("LINK_SWEEP_ADDRESS", cltest.NewAddress().Hex())
+	_, err = services.NewLinkSweeper(store).Sweep(1)
+	assert.Error(t, err)
+}
+
+func TestLinkSweeper_OnNewHead(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	txm := mocks.NewMockTxManager(ctrl)
+	store.TxManager = txm
+
+	sweeper := services.NewLinkSweeper(store)
+	require.NoError(t, sweeper.Connect(nil))
+	defer sweeper.Disconnect()
+	sweeper.OnNewHead(cltest.NewBlockHeader(10)) // disabled without a sweep address
+
+	store.Config.Set("ORACLE_CONTRACT_ADDRESS", cltest.NewAddress().Hex())
+	store.Config.Set("LINK_SWEEP_ADDRESS", cltest.NewAddress().Hex())
+	store.Config.Set("LINK_SWEEP_BLOCKS", 2)
+	checked := make(chan struct{}, 2)
+	txm.EXPECT().ContractLINKBalance(gomock.Any()).Return(*assets.NewLink(0), nil).Times(2).Do(
+		func(models.WithdrawalRequest) { checked <- struct{}{} },
+	)
+
+	g := gomega.NewGomegaWithT(t)
+	sweeper.OnNewHead(cltest.NewBlockHeader(10))
+	g.Eventually(checked).Should(gomega.Receive())
+	sweeper.OnNewHead(cltest.NewBlockHeader(11))
+	sweeper.OnNewHead(cltest.NewBlockHeader(12))
+	g.Eventually(checked).Should(gomega.Receive())
+}
+
+func TestLinkSweeper_Sweep_PendingSweep(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	txm := mocks.NewMockTxManager(ctrl)
+	store.TxManager = txm
+	store.Config.Set("ORACLE_CONTRACT_ADDRESS", cltest.NewAddress().Hex())
+	store.Config.Set("LINK_SWEEP_ADDRESS", cltest.NewAddress().Hex())
+
+	tx := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 1)
+	attempts, err := store.TxAttemptsFor(tx.ID)
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	pending := models.NewLinkSweep()
+	pending.TxHash = attempts[0].Hash
+	require.NoError(t, store.SaveLinkSweep(&pending))
+
+	sweep, err := services.NewLinkSweeper(store).Sweep(42)
+	require.NoError(t, err)
+	assert.Nil(t, sweep)
+
+	require.NoError(t, store.ConfirmTx(tx, &attempts[0]))
+	txm.EXPECT().ContractLINKBalance(gomock.Any()).Return(*assets.NewLink(0), nil)
+	sweep, err = services.NewLinkSweeper(store).Sweep(43)
+	require.NoError(t, err)
+	assert.Nil(t, sweep)
+}
@@ -12,12 +12,18 @@ type storeReaper struct {
 	config store.Config
 }
 
-// NewStoreReaper creates a reaper that cleans stale objects from the store.
+// NewStoreReaper creates a reaper that cleans stale objects from the store:
+// sessions that have not been used for a while, and the job runs the run
+// retention policy deletes. Besides being woken up, it runs on its own
+// every ReaperInterval.
 func NewStoreReaper(store *store.Store) SleeperTask {
-	return NewSleeperTask(&storeReaper{
-		store:  store,
-		config: store.Config,
-	})
+	return &periodicSleeperTask{
+		SleeperTask: NewSleeperTask(&storeReaper{
+			store:  store,
+			config: store.Config,
+		}),
+		interval: store.Config.ReaperInterval(),
+	}
 }
 
 func (sr *storeReaper) Work() {
@@ -26,4 +32,50 @@ func (sr *storeReaper) Work() {
 	if err != nil {
 		logger.Error("unable to reap stale sessions: ", err)
 	}
+
+	policy := NewRunRetentionPolicy(sr.config)
+	if err := ReapJobRuns(sr.store.ORM, policy, sr.store.Clock.Now()); err != nil {
+		logger.Error("unable to reap job runs: ", err)
+	}
+}
+
+// periodicSleeperTask wakes up its SleeperTask every interval, in addition
+// to when WakeUp is called. A zero interval only wakes it up on demand.
+type periodicSleeperTask struct {
+	SleeperTask
+	interval time.Duration
+	done     chan struct{}
+}
+
+func (pst *periodicSleeperTask) Start() error {
+	if err := pst.SleeperTask.Start(); err != nil {
+		return err
+	}
+	if pst.interval > 0 {
+		pst.done = make(chan struct{})
+		go pst.tickLoop(pst.done)
+	}
+	return nil
+}
+
+func (pst *periodicSleeperTask) Stop() error {
+	if pst.done != nil {
+		close(pst.done)
+		pst.done = nil
+	}
+	return pst.SleeperTask.Stop()
+}
+
+func (pst *periodicSleeperTask) tickLoop(done chan struct{}) {
+	ticker := time.NewTicker(pst.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			pst.WakeUp()
+		case <-done:
+			return
+		}
+	}
 }
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"github.com/smartcontractkit/chainlink/utils"
+)
+
+// RunExportFormat is the encoding used when exporting job runs.
+type RunExportFormat string
+
+const (
+	// RunExportFormatCSV writes a header row followed by one row per run.
+	RunExportFormatCSV = RunExportFormat("csv")
+	// RunExportFormatNDJSON writes one JSON object per line for each run.
+	RunExportFormatNDJSON = RunExportFormat("ndjson")
+)
+
+// NewRunExportFormat validates and returns a RunExportFormat, defaulting to
+// CSV when none is given.
+func NewRunExportFormat(val string) (RunExportFormat, error) {
+	switch format := RunExportFormat(strings.ToLower(val)); format {
+	case "":
+		return RunExportFormatCSV, nil
+	case RunExportFormatCSV, RunExportFormatNDJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%v is not a supported export format", val)
+	}
+}
+
+// ContentType returns the MIME type of the export format.
+func (f RunExportFormat) ContentType() string {
+	if f == RunExportFormatNDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv"
+}
+
+// RunExportRecord is a completed job run flattened for accounting.
+type RunExportRecord struct {
+	RunID         string          `json:"runId"`
+	JobID         string          `json:"jobId"`
+	InitiatorType string          `json:"initiatorType"`
+	Requester     *common.Address `json:"requester"`
+	Amount        *assets.Link    `json:"amount"`
+	TxHashes      []common.Hash   `json:"txHashes"`
+	GasUsed       *big.Int        `json:"gasUsed"`
+	GasCost       *assets.Eth     `json:"gasCost"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	CompletedAt   time.Time       `json:"completedAt"`
+}
+
+var runExportCSVHeader = []string{
+	"run_id",
+	"job_id",
+	"initiator_type",
+	"requester",
+	"amount",
+	"tx_hashes",
+	"gas_used",
+	"gas_cost_wei",
+	"created_at",
+	"completed_at",
+}
+
+func (r RunExportRecord) csvRow() []string {
+	requester := ""
+	if r.Requester != nil {
+		requester = r.Requester.Hex()
+	}
+	amount := ""
+	if r.Amount != nil {
+		amount = r.Amount.Text(10)
+	}
+	hashes := make([]string, len(r.TxHashes))
+	for i, h := range r.TxHashes {
+		hashes[i] = h.Hex()
+	}
+
+	return []string{
+		r.RunID,
+		r.JobID,
+		r.InitiatorType,
+		requester,
+		amount,
+		strings.Join(hashes, ";"),
+		r.GasUsed.String(),
+		r.GasCost.ToInt().String(),
+		utils.ISO8601UTC(r.CreatedAt),
+		utils.ISO8601UTC(r.CompletedAt),
+	}
+}
+
+// RunGas holds the gas used by the transactions a run sent, and what that
+// gas cost at the price of each confirmed attempt.
+type RunGas struct {
+	TxHashes []common.Hash
+	GasUsed  *big.Int
+	GasCost  *assets.Eth
+}
+
+// RunReceipts returns the ethereum receipts recorded in the run's result.
+func RunReceipts(run models.JobRun) ([]strpkg.TxReceipt, error) {
+	receipts := []strpkg.TxReceipt{}
+	data := run.Result.Get("ethereumReceipts")
+	if !data.IsArray() {
+		return receipts, nil
+	}
+	err := json.Unmarshal([]byte(data.Raw), &receipts)
+	return receipts, err
+}
+
+// GasForRun totals the gas used by the transactions the run sent, priced at
+// the gas price of the attempt that was confirmed.
+func GasForRun(store *orm.ORM, run models.JobRun) (RunGas, error) {
+	gas := RunGas{
+		TxHashes: []common.Hash{},
+		GasUsed:  big.NewInt(0),
+		GasCost:  assets.NewEth(0),
+	}
+
+	receipts, err := RunReceipts(run)
+	if err != nil {
+		return gas, err
+	}
+
+	for _, receipt := range receipts {
+		gas.TxHashes = append(gas.TxHashes, receipt.Hash)
+		if receipt.GasUsed == nil {
+			continue
+		}
+		gas.GasUsed.Add(gas.GasUsed, receipt.GasUsed.ToBig())
+
+		attempt, err := store.FindTxAttempt(receipt.Hash)
+		if err == orm.ErrorNotFound {
+			continue
+		} else if err != nil {
+			return gas, fmt.Errorf("unable to find tx attempt %s: %v", receipt.Hash.Hex(), err)
+		}
+		cost := new(big.Int).Mul(receipt.GasUsed.ToBig(), attempt.GasPrice)
+		gas.GasCost.Add(gas.GasCost, (*assets.Eth)(cost))
+	}
+	return gas, nil
+}
+
+// runPayment returns the LINK paid for the run, falling back to the amount
+// supplied when the run was started.
+func runPayment(run models.JobRun) *assets.Link {
+	if run.Result.Amount != nil {
+		return run.Result.Amount
+	}
+	return run.Overrides.Amount
+}
+
+// NewRunExportRecord builds the export record for a completed run.
+func NewRunExportRecord(store *orm.ORM, run models.JobRun) (RunExportRecord, error) {
+	gas, err := GasForRun(store, run)
+	if err != nil {
+		return RunExportRecord{}, err
+	}
+
+	return RunExportRecord{
+		RunID:         run.ID,
+		JobID:         run.JobID,
+		InitiatorType: run.Initiator.Type,
+		Requester:     run.Requester,
+		Amount:        runPayment(run),
+		TxHashes:      gas.TxHashes,
+		GasUsed:       gas.GasUsed,
+		GasCost:       gas.GasCost,
+		CreatedAt:     run.CreatedAt,
+		CompletedAt:   run.CompletedAt.Time,
+	}, nil
+}
+
+// ExportRuns writes every run completed within [from, to) to w in the
+// requested format. Zero times leave that end of the range open. Runs are
+// read in batches so the whole table is never held in memory.
+func ExportRuns(store *orm.ORM, w io.Writer, format RunExportFormat, from, to time.Time) error {
+	var write func(RunExportRecord) error
+	var flush func() error
+	switch format {
+	case RunExportFormatNDJSON:
+		encoder := json.NewEncoder(w)
+		write = func(r RunExportRecord) error { return encoder.Encode(r) }
+		flush = func() error { return nil }
+	default:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(runExportCSVHeader); err != nil {
+			return err
+		}
+		write = func(r RunExportRecord) error { return cw.Write(r.csvRow()) }
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	}
+
+	var merr error
+	err := store.AllInBatches(&[]models.JobRun{}, func(run models.JobRun) bool {
+		if !run.Status.Completed() || !run.CompletedAt.Valid {
+			return true
+		}
+		completedAt := run.CompletedAt.Time
+		if (!from.IsZero() && completedAt.Before(from)) || (!to.IsZero() && !completedAt.Before(to)) {
+			return true
+		}
+
+		record, err := NewRunExportRecord(store, run)
+		if err != nil {
+			merr = err
+			return false
+		}
+		if merr = write(record); merr != nil {
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	} else if merr != nil {
+		return merr
+	}
+	return flush()
+}
@@ -0,0 +1,113 @@
+package services_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v3"
+)
+
+func TestNewRunExportFormat(t *testing.T) {
+	t.Parallel()
+
+	format, err := services.NewRunExportFormat("")
+	require.NoError(t, err)
+	assert.Equal(t, services.RunExportFormatCSV, format)
+
+	format, err = services.NewRunExportFormat("NDJSON")
+	require.NoError(t, err)
+	assert.Equal(t, services.RunExportFormatNDJSON, format)
+	assert.Equal(t, "application/x-ndjson", format.ContentType())
+
+	_, err = services.NewRunExportFormat("xml")
+	assert.Error(t, err)
+}
+
+func TestGasForRun(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	confirmed := common.HexToHash("0x1")
+	unknown := common.HexToHash("0x2")
+	require.NoError(t, store.Save(&models.TxAttempt{Hash: confirmed, GasPrice: big.NewInt(20)}))
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	run := job.NewRun(initr)
+	run.Result = cltest.RunResultWithData(`{"ethereumReceipts":[` +
+		`{"transactionHash":"` + confirmed.Hex() + `","gasUsed":"0x5208"},` +
+		`{"transactionHash":"` + unknown.Hex() + `","gasUsed":"0x64"}]}`)
+
+	gas, err := services.GasForRun(store.ORM, run)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Hash{confirmed, unknown}, gas.TxHashes)
+	assert.Equal(t, big.NewInt(21100), gas.GasUsed)
+	assert.Equal(t, assets.NewEth(21000*20), gas.GasCost)
+}
+
+func TestExportRuns(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+
+	january := job.NewRun(initr)
+	january.Status = models.RunStatusCompleted
+	january.CompletedAt = null.TimeFrom(cltest.ParseISO8601("2018-01-15T00:00:00Z"))
+	january.Result.Amount = assets.NewLink(100)
+	require.NoError(t, store.SaveJobRun(&january))
+	february := job.NewRun(initr)
+	february.Status = models.RunStatusCompleted
+	february.CompletedAt = null.TimeFrom(cltest.ParseISO8601("2018-02-15T00:00:00Z"))
+	require.NoError(t, store.SaveJobRun(&february))
+	errored := job.NewRun(initr)
+	errored.Status = models.RunStatusErrored
+	errored.CompletedAt = null.TimeFrom(cltest.ParseISO8601("2018-01-20T00:00:00Z"))
+	require.NoError(t, store.SaveJobRun(&errored))
+
+	from := cltest.ParseISO8601("2018-01-01T00:00:00Z")
+	to := cltest.ParseISO8601("2018-02-01T00:00:00Z")
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, services.ExportRuns(store.ORM, &buf, services.RunExportFormatCSV, from, to))
+
+		rows, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, "run_id", rows[0][0])
+		assert.Equal(t, january.ID, rows[1][0])
+		assert.Equal(t, "web", rows[1][2])
+		assert.Equal(t, "100", rows[1][4])
+		assert.Equal(t, "2018-01-15T00:00:00Z", rows[1][9])
+	})
+
+	t.Run("ndjson with open range", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, services.ExportRuns(store.ORM, &buf, services.RunExportFormatNDJSON, time.Time{}, time.Time{}))
+
+		var ids []string
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			var record services.RunExportRecord
+			require.NoError(t, json.Unmarshal([]byte(line), &record))
+			ids = append(ids, record.RunID)
+		}
+		assert.ElementsMatch(t, []string{january.ID, february.ID}, ids)
+	})
+}
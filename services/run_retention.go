@@ -0,0 +1,109 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+)
+
+// NewRunRetentionPolicy returns the run retention policy in the config.
+func NewRunRetentionPolicy(config store.Config) models.RunRetentionPolicy {
+	return models.RunRetentionPolicy{
+		MaxAge:   config.RunRetentionMaxAge(),
+		MaxCount: config.RunRetentionMaxCount(),
+		Statuses: config.RunRetentionStatuses(),
+	}
+}
+
+// RunsToReap returns the runs the policy deletes at the given time, least
+// recently updated first.
+func RunsToReap(orm *orm.ORM, policy models.RunRetentionPolicy, now time.Time) ([]models.JobRun, error) {
+	if !policy.Enabled() {
+		return []models.JobRun{}, nil
+	}
+
+	reaped := map[string]models.JobRun{}
+	if policy.MaxAge > 0 {
+		query := policy.AgeQuery(now)
+		runs, err := orm.JobRunsMatching(&query)
+		if err != nil {
+			return nil, err
+		}
+		for _, run := range runs {
+			reaped[run.ID] = run
+		}
+	}
+	if policy.MaxCount > 0 {
+		runs, err := runsBeyondMaxCount(orm, policy)
+		if err != nil {
+			return nil, err
+		}
+		for _, run := range runs {
+			reaped[run.ID] = run
+		}
+	}
+
+	runs := []models.JobRun{}
+	for _, run := range reaped {
+		runs = append(runs, run)
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].UpdatedAt.Before(runs[j].UpdatedAt)
+	})
+	return runs, nil
+}
+
+// runsBeyondMaxCount returns the runs of each job and status that are older
+// than its MaxCount most recently updated runs.
+func runsBeyondMaxCount(orm *orm.ORM, policy models.RunRetentionPolicy) ([]models.JobRun, error) {
+	ids, err := orm.JobRunIDsBeyondCount(policy.Statuses, policy.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]models.JobRun, len(ids))
+	for i, id := range ids {
+		if runs[i], err = orm.FindJobRun(id); err != nil {
+			return nil, err
+		}
+	}
+	return runs, nil
+}
+
+// ReapJobRuns deletes the runs the policy deletes at the given time. Runs
+// past the maximum age are removed like a bulk delete of runs updated before
+// the cutoff.
+func ReapJobRuns(orm *orm.ORM, policy models.RunRetentionPolicy, now time.Time) error {
+	if !policy.Enabled() {
+		return nil
+	}
+
+	if policy.MaxAge > 0 {
+		query := policy.AgeQuery(now)
+		if err := DeleteJobRuns(orm, &query); err != nil {
+			return err
+		}
+	}
+	if policy.MaxCount > 0 {
+		ids, err := orm.JobRunIDsBeyondCount(policy.Statuses, policy.MaxCount)
+		if err != nil {
+			return err
+		}
+		return orm.DeleteJobRuns(ids)
+	}
+	return nil
+}
+
+// RunRetentionDryRun reports the runs the policy would delete at the given
+// time, without deleting them.
+func RunRetentionDryRun(orm *orm.ORM, policy models.RunRetentionPolicy, now time.Time) (presenters.RunRetention, error) {
+	runs, err := RunsToReap(orm, policy, now)
+	if err != nil {
+		return presenters.RunRetention{}, err
+	}
+	return presenters.NewRunRetention(policy, runs), nil
+}
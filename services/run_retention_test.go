@@ -0,0 +1,166 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func saveRunUpdatedAt(t *testing.T, s *store.Store, job models.JobSpec, status models.RunStatus, updatedAt time.Time) models.JobRun {
+	run := job.NewRun(job.Initiators[0])
+	run.Status = status
+	run.UpdatedAt = updatedAt
+	require.NoError(t, s.ORM.DB.Save(&run))
+	return run
+}
+
+func runIDs(runs []models.JobRun) []string {
+	ids := []string{}
+	for _, run := range runs {
+		ids = append(ids, run.ID)
+	}
+	return ids
+}
+
+func TestRunsToReap(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	now := cltest.ParseISO8601("2018-02-01T00:00:00Z")
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	other, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&other))
+
+	oldCompleted := saveRunUpdatedAt(t, store, job, models.RunStatusCompleted, now.Add(-72*time.Hour))
+	completed := saveRunUpdatedAt(t, store, job, models.RunStatusCompleted, now.Add(-3*time.Hour))
+	saveRunUpdatedAt(t, store, job, models.RunStatusCompleted, now.Add(-time.Hour))
+	oldErrored := saveRunUpdatedAt(t, store, job, models.RunStatusErrored, now.Add(-72*time.Hour))
+	saveRunUpdatedAt(t, store, job, models.RunStatusInProgress, now.Add(-72*time.Hour))
+	saveRunUpdatedAt(t, store, other, models.RunStatusCompleted, now.Add(-2*time.Hour))
+
+	tests := []struct {
+		name   string
+		policy models.RunRetentionPolicy
+		want   []string
+	}{
+		{"disabled", models.RunRetentionPolicy{
+			Statuses: []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored},
+		}, []string{}},
+		{"max age", models.RunRetentionPolicy{
+			MaxAge:   48 * time.Hour,
+			Statuses: []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored},
+		}, []string{oldCompleted.ID, oldErrored.ID}},
+		{"max age of completed runs", models.RunRetentionPolicy{
+			MaxAge:   48 * time.Hour,
+			Statuses: []models.RunStatus{models.RunStatusCompleted},
+		}, []string{oldCompleted.ID}},
+		{"max count per job and status", models.RunRetentionPolicy{
+			MaxCount: 1,
+			Statuses: []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored},
+		}, []string{oldCompleted.ID, completed.ID}},
+		{"max age and count", models.RunRetentionPolicy{
+			MaxAge:   48 * time.Hour,
+			MaxCount: 2,
+			Statuses: []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored},
+		}, []string{oldCompleted.ID, oldErrored.ID}},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			runs, err := services.RunsToReap(store.ORM, test.policy, now)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, test.want, runIDs(runs))
+		})
+	}
+}
+
+func TestReapJobRuns(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	now := cltest.ParseISO8601("2018-02-01T00:00:00Z")
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+
+	saveRunUpdatedAt(t, store, job, models.RunStatusCompleted, now.Add(-72*time.Hour))
+	saveRunUpdatedAt(t, store, job, models.RunStatusCompleted, now.Add(-3*time.Hour))
+	kept := saveRunUpdatedAt(t, store, job, models.RunStatusCompleted, now.Add(-time.Hour))
+	inProgress := saveRunUpdatedAt(t, store, job, models.RunStatusInProgress, now.Add(-72*time.Hour))
+
+	policy := models.RunRetentionPolicy{
+		MaxAge:   48 * time.Hour,
+		MaxCount: 1,
+		Statuses: []models.RunStatus{models.RunStatusCompleted},
+	}
+	require.NoError(t, services.ReapJobRuns(store.ORM, policy, now))
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{kept.ID, inProgress.ID}, runIDs(runs))
+}
+
+func TestRunRetentionDryRun(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	now := cltest.ParseISO8601("2018-02-01T00:00:00Z")
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	old := saveRunUpdatedAt(t, store, job, models.RunStatusErrored, now.Add(-72*time.Hour))
+	saveRunUpdatedAt(t, store, job, models.RunStatusErrored, now.Add(-time.Hour))
+
+	policy := models.RunRetentionPolicy{
+		MaxAge:   48 * time.Hour,
+		Statuses: []models.RunStatus{models.RunStatusErrored},
+	}
+	report, err := services.RunRetentionDryRun(store.ORM, policy, now)
+	require.NoError(t, err)
+	assert.True(t, report.Enabled)
+	assert.Equal(t, 1, report.RunCount)
+	require.Len(t, report.Runs, 1)
+	assert.Equal(t, old.ID, report.Runs[0].RunID)
+	assert.Equal(t, job.ID, report.Runs[0].JobID)
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	assert.Len(t, runs, 2, "expected a dry run not to delete runs")
+}
+
+func TestStoreReaper_ReapJobRuns(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("RUN_RETENTION_MAX_COUNT", "1")
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	saveRunUpdatedAt(t, store, job, models.RunStatusCompleted, time.Now().Add(-time.Hour))
+	kept := saveRunUpdatedAt(t, store, job, models.RunStatusCompleted, time.Now())
+
+	r := services.NewStoreReaper(store)
+	require.NoError(t, r.Start())
+	defer r.Stop()
+	r.WakeUp()
+
+	gomega.NewGomegaWithT(t).Eventually(func() []string {
+		runs, err := store.JobRunsFor(job.ID)
+		assert.NoError(t, err)
+		return runIDs(runs)
+	}).Should(gomega.Equal([]string{kept.ID}))
+}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
 	"github.com/smartcontractkit/chainlink/utils"
 )
 
@@ -21,6 +23,16 @@ func ExecuteJob(
 	input models.RunResult,
 	creationHeight *hexutil.Big,
 	store *store.Store) (*models.JobRun, error) {
+	return executeJob(job, initiator, input, creationHeight, nil, store)
+}
+
+func executeJob(
+	job models.JobSpec,
+	initiator models.Initiator,
+	input models.RunResult,
+	creationHeight *hexutil.Big,
+	requester *common.Address,
+	store *store.Store) (*models.JobRun, error) {
 
 	logger.Debugw(fmt.Sprintf("New run triggered by %s", initiator.Type),
 		"job", job.ID,
@@ -32,6 +44,7 @@ func ExecuteJob(
 	if err != nil {
 		return nil, err
 	}
+	run.Requester = requester
 
 	return run, saveAndTrigger(run, store)
 }
@@ -45,6 +58,12 @@ func NewRun(
 	currentHeight *hexutil.Big,
 	store *store.Store) (*models.JobRun, error) {
 
+	if job.Archived() {
+		return nil, RecurringScheduleJobError{
+			msg: fmt.Sprintf("Job runner: Job %v was archived at %v", job.ID, job.ArchivedAt.Time),
+		}
+	}
+
 	now := store.Clock.Now()
 	if !job.Started(now) {
 		return nil, RecurringScheduleJobError{
@@ -183,6 +202,27 @@ func ResumeConnectingTask(
 	return run, saveAndTrigger(run, store)
 }
 
+// ResumeFundedTask resumes a run that was left in pending_funds.
+func ResumeFundedTask(
+	run *models.JobRun,
+	store *store.Store,
+) (*models.JobRun, error) {
+
+	logger.Debugw("Funds arrived, resuming run", run.ForLogger()...)
+
+	if !run.Status.PendingFunds() {
+		return run, fmt.Errorf("Attempt to resume task not pending funds")
+	}
+
+	currentTaskRun := run.NextTaskRun()
+	if currentTaskRun == nil {
+		return run, fmt.Errorf("Attempting to resume run pending funds with no remaining tasks %s", run.ID)
+	}
+
+	run.Status = models.RunStatusInProgress
+	return run, saveAndTrigger(run, store)
+}
+
 // ResumePendingTask takes the body provided from an external adapter,
 // saves it for the next task to process, then tells the job runner to execute
 // it
@@ -322,6 +362,11 @@ func saveAndTrigger(run *models.JobRun, store *store.Store) error {
 	if err := store.SaveJobRun(run); err != nil {
 		return err
 	}
+	store.RunNotifier.Notify(*run)
+	store.Events.PublishJobRun(*run)
+	if err := recordDeviationSubmission(run, store); err != nil {
+		logger.Errorw("Error recording submitted value", run.ForLogger("error", err)...)
+	}
 
 	if run.Status == models.RunStatusInProgress {
 		logger.Debugw(fmt.Sprintf("Executing run originally initiated by %s", run.Initiator.Type), run.ForLogger()...)
@@ -332,6 +377,36 @@ func saveAndTrigger(run *models.JobRun, store *store.Store) error {
 	return nil
 }
 
+// recordDeviationSubmission saves the value that passed the "deviation" task
+// of a completed run as the job's last submitted value, or clears it from
+// flight once the run has errored.
+func recordDeviationSubmission(run *models.JobRun, store *store.Store) error {
+	if !run.Status.Errored() && (!run.Status.Completed() || run.Result.Skipped) {
+		return nil
+	}
+	for _, tr := range run.TaskRuns {
+		if tr.Task.Type != adapters.TaskTypeDeviation || !tr.Status.Completed() {
+			continue
+		}
+		ds, err := store.FindDeviationSubmission(run.JobID)
+		if err == orm.ErrorNotFound && run.Status.Errored() {
+			return nil
+		} else if err == orm.ErrorNotFound {
+			ds = models.DeviationSubmission{JobID: run.JobID}
+		} else if err != nil {
+			return err
+		}
+
+		if run.Status.Errored() {
+			ds.Abort(*run)
+		} else {
+			ds.Submitted(*run, tr.Result.Get("value").String(), store.Clock.Now())
+		}
+		return store.SaveDeviationSubmission(&ds)
+	}
+	return nil
+}
+
 // RecurringScheduleJobError contains the field for the error message.
 type RecurringScheduleJobError struct {
 	msg string
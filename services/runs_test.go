@@ -46,6 +46,17 @@ func TestNewRun(t *testing.T) {
 	assert.Equal(t, input, run.Overrides.Data)
 }
 
+func TestNewRun_archivedJob(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	jobSpec, initr := cltest.NewJobWithWebInitiator()
+	jobSpec.ArchivedAt = cltest.NullableTime(time.Now())
+
+	_, err := services.NewRun(jobSpec, initr, models.RunResult{}, nil, store)
+	assert.IsType(t, services.RecurringScheduleJobError{}, err)
+}
+
 func TestNewRun_requiredPayment(t *testing.T) {
 	store, cleanup := cltest.NewStore()
 	defer cleanup()
@@ -279,6 +290,31 @@ func TestResumeConnectingTask(t *testing.T) {
 	assert.Equal(t, string(models.RunStatusInProgress), string(run.Status))
 }
 
+func TestResumeFundedTask(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	// reject a run with an invalid state
+	run := &models.JobRun{Status: models.RunStatusPendingConnection}
+	run, err := services.ResumeFundedTask(run, store)
+	assert.Error(t, err)
+
+	// reject a run with no tasks
+	run = &models.JobRun{Status: models.RunStatusPendingFunds}
+	run, err = services.ResumeFundedTask(run, store)
+	assert.Error(t, err)
+
+	// should go from pending funds -> in progress
+	run = &models.JobRun{
+		ID:       utils.NewBytes32ID(),
+		Status:   models.RunStatusPendingFunds,
+		TaskRuns: []models.TaskRun{models.TaskRun{Task: models.TaskSpec{Type: adapters.TaskTypeNoOp}}},
+	}
+	run, err = services.ResumeFundedTask(run, store)
+	assert.NoError(t, err)
+	assert.Equal(t, string(models.RunStatusInProgress), string(run.Status))
+}
+
 func sleepAdapterParams(n int) models.JSON {
 	d := time.Duration(n)
 	json := []byte(fmt.Sprintf(`{"until":%v}`, time.Now().Add(d*time.Second).Unix()))
@@ -2,6 +2,8 @@ package services
 
 import (
 	"errors"
+	"fmt"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // Scheduler contains fields for Recurring and OneTime for occurrences,
@@ -17,6 +20,7 @@ import (
 type Scheduler struct {
 	Recurring    *Recurring
 	OneTime      *OneTime
+	Blocks       *BlockInterval
 	store        *store.Store
 	startedMutex sync.RWMutex
 	started      bool
@@ -31,14 +35,15 @@ func NewScheduler(store *store.Store) *Scheduler {
 			Store: store,
 			Clock: store.Clock,
 		},
-		store: store,
+		Blocks: NewBlockInterval(store),
+		store:  store,
 	}
 }
 
 // Start checks to ensure the Scheduler has not already started,
 // calls the Start function for both Recurring and OneTime types,
 // sets the started field to true, and adds jobs relevant to its
-// initiator ("cron" and "runat").
+// initiator ("cron", "runat" and "interval").
 func (s *Scheduler) Start() error {
 	s.startedMutex.Lock()
 	defer s.startedMutex.Unlock()
@@ -67,6 +72,7 @@ func (s *Scheduler) Stop() {
 	if s.started {
 		s.Recurring.Stop()
 		s.OneTime.Stop()
+		s.Blocks.Stop()
 		s.started = false
 	}
 }
@@ -74,6 +80,7 @@ func (s *Scheduler) Stop() {
 func (s *Scheduler) addJob(job models.JobSpec) {
 	s.Recurring.AddJob(job)
 	s.OneTime.AddJob(job)
+	s.Blocks.AddJob(job)
 }
 
 // AddJob is the governing function for Recurring and OneTime,
@@ -87,57 +94,192 @@ func (s *Scheduler) AddJob(job models.JobSpec) {
 	s.addJob(job)
 }
 
+// RemoveJob stops the job from being run by Recurring, OneTime and Blocks.
+func (s *Scheduler) RemoveJob(ID string) {
+	s.Recurring.RemoveJob(ID)
+	s.OneTime.RemoveJob(ID)
+	s.Blocks.RemoveJob(ID)
+}
+
+// maxCatchUpRuns is the most missed runs of a "cron" initiator that are
+// started when catching up with the "all" policy.
+const maxCatchUpRuns = 100
+
 // Recurring is used for runs that need to execute on a schedule,
 // and is configured with cron.
 // Instances of Recurring must be initialized using NewRecurring().
 type Recurring struct {
-	Cron  Cron
-	Clock Nower
-	store *store.Store
+	Cron          Cron
+	Clock         store.AfterNower
+	store         *store.Store
+	done          chan struct{}
+	registrations jobRegistrations
+	mutex         sync.Mutex
+	entries       map[string][]CronEntryID
 }
 
 // NewRecurring create a new instance of Recurring, ready to use.
 func NewRecurring(store *store.Store) *Recurring {
 	return &Recurring{
-		store: store,
-		Clock: store.Clock,
+		store:   store,
+		Clock:   store.Clock,
+		done:    make(chan struct{}),
+		entries: map[string][]CronEntryID{},
 	}
 }
 
 // Start for Recurring types executes tasks with a "cron" initiator
 // based on the configured schedule for the run.
 func (r *Recurring) Start() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.done = make(chan struct{})
+	r.entries = map[string][]CronEntryID{}
 	r.Cron = newChainlinkCron()
 	r.Cron.Start()
 	return nil
 }
 
 // Stop stops the cron scheduler and waits for running jobs to finish.
+// Runs still waiting out their jitter are dropped.
 func (r *Recurring) Stop() {
+	close(r.done)
 	r.Cron.Stop()
 }
 
-// AddJob looks for "cron" initiators, adds them to cron's schedule
-// for execution when specified.
+// AddJob looks for "cron" initiators, catches up on the runs they missed
+// according to their catch-up policy in the background, and adds them to cron's schedule
+// for execution when specified. Time "interval" initiators are added to
+// cron's schedule to run every period. Any schedules added for an earlier
+// version of the job are removed.
 func (r *Recurring) AddJob(job models.JobSpec) {
-	for _, i := range job.InitiatorsFor(models.InitiatorCron) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.Cron.Remove(r.entries[job.ID]...)
+	delete(r.entries, job.ID)
+
+	generation := r.registrations.register(job.ID)
+	for _, i := range job.InitiatorsFor(models.InitiatorCron, models.InitiatorInterval) {
 		initr := i
-		if !job.Ended(r.Clock.Now()) {
-			r.Cron.AddFunc(string(initr.Schedule), func() {
-				_, err := ExecuteJob(job, initr, models.RunResult{}, nil, r.store)
-				if err != nil && !expectedRecurringScheduleJobError(err) {
-					logger.Errorw(err.Error())
-				}
-			})
+		if job.Ended(r.Clock.Now()) || initr.IsBlockInterval() {
+			continue
+		}
+		schedule, err := recurringSchedule(initr)
+		if err != nil {
+			logger.Errorw("Unable to schedule initiator", "job", job.ID, "initiator", initr.ID, "error", err)
+			continue
+		}
+		go r.catchUp(job, initr, generation)
+		id := r.Cron.Schedule(schedule, cron.FuncJob(func() {
+			r.fire(job, initr, generation)
+		}))
+		r.entries[job.ID] = append(r.entries[job.ID], id)
+	}
+}
+
+func recurringSchedule(initr models.Initiator) (cron.Schedule, error) {
+	if initr.Type == models.InitiatorInterval {
+		if initr.Period.Duration() < time.Second {
+			return nil, fmt.Errorf("interval period %v is shorter than a second", initr.Period)
 		}
+		return cron.Every(initr.Period.Duration()), nil
+	}
+	return initr.CronSchedule()
+}
+
+// RemoveJob stops the cron functions added for the job from running, and
+// removes them from cron's schedule.
+func (r *Recurring) RemoveJob(ID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.registrations.remove(ID)
+	r.Cron.Remove(r.entries[ID]...)
+	delete(r.entries, ID)
+}
+
+func (r *Recurring) fire(job models.JobSpec, initr models.Initiator, generation uint64) {
+	if jitter := initr.Jitter.Duration(); jitter > 0 {
+		select {
+		case <-r.done:
+			return
+		case <-r.Clock.After(time.Duration(rand.Int63n(int64(jitter)))):
+		}
+	}
+	if !r.registrations.current(job.ID, generation) {
+		return
+	}
+	r.recordFire(initr, r.Clock.Now())
+	_, err := ExecuteJob(job, initr, models.RunResult{}, nil, r.store)
+	if err != nil && !expectedRecurringScheduleJobError(err) {
+		logger.Errorw(err.Error())
+	}
+}
+
+// catchUp starts the runs the initiator missed since it last fired, as
+// persisted in the store, following its catch-up policy. It gives up once
+// Recurring is stopped or the job is added again or removed.
+func (r *Recurring) catchUp(job models.JobSpec, initr models.Initiator, generation uint64) {
+	if initr.CatchUp == "" || initr.CatchUp == models.CatchUpSkip {
+		return
+	}
+	state, err := r.store.FindInitiatorState(initr.ID)
+	if err != nil {
+		logger.Errorw("Unable to load cron initiator state", "job", job.ID, "initiator", initr.ID, "error", err)
+		return
+	}
+	now := r.Clock.Now()
+	missed, truncated, err := initr.MissedFires(state, now, maxCatchUpRuns)
+	if err != nil {
+		logger.Errorw("Unable to compute missed cron runs", "job", job.ID, "initiator", initr.ID, "error", err)
+		return
+	} else if len(missed) == 0 {
+		return
+	} else if truncated {
+		logger.Warnw(fmt.Sprintf("Cron initiator missed more than %v runs, skipping the rest", maxCatchUpRuns),
+			"job", job.ID, "initiator", initr.ID)
+	}
+	if initr.CatchUp == models.CatchUpOnce {
+		missed = missed[len(missed)-1:]
+	}
+
+	logger.Infow(fmt.Sprintf("Catching up on %v missed cron runs", len(missed)), "job", job.ID, "initiator", initr.ID)
+	r.recordFire(initr, now)
+	for range missed {
+		select {
+		case <-r.done:
+			return
+		default:
+		}
+		if !r.registrations.current(job.ID, generation) {
+			return
+		}
+		_, err := ExecuteJob(job, initr, models.RunResult{}, nil, r.store)
+		if err != nil && !expectedRecurringScheduleJobError(err) {
+			logger.Errorw(err.Error())
+		}
+	}
+}
+
+// recordFire persists the time the initiator last fired, so runs missed
+// while the node is down can be caught up on.
+func (r *Recurring) recordFire(initr models.Initiator, at time.Time) {
+	state, err := r.store.FindInitiatorState(initr.ID)
+	if err != nil {
+		logger.Errorw("Unable to load cron initiator state", "initiator", initr.ID, "error", err)
+		return
+	}
+	state.LastFiredAt = null.TimeFrom(at)
+	if err := r.store.SaveInitiatorState(&state); err != nil {
+		logger.Errorw("Unable to record cron initiator fire time", "initiator", initr.ID, "error", err)
 	}
 }
 
 // OneTime represents runs that are to be executed only once.
 type OneTime struct {
-	Store *store.Store
-	Clock Afterer
-	done  chan struct{}
+	Store         *store.Store
+	Clock         Afterer
+	done          chan struct{}
+	registrations jobRegistrations
 }
 
 // Start allocates a channel for the "done" field with an empty struct.
@@ -148,11 +290,17 @@ func (ot *OneTime) Start() error {
 
 // AddJob runs the job at the time specified for the "runat" initiator.
 func (ot *OneTime) AddJob(job models.JobSpec) {
+	generation := ot.registrations.register(job.ID)
 	for _, initr := range job.InitiatorsFor(models.InitiatorRunAt) {
-		go ot.RunJobAt(initr, job)
+		go ot.runJobAt(initr, job, generation)
 	}
 }
 
+// RemoveJob stops pending "runat" initiators of the job from running.
+func (ot *OneTime) RemoveJob(ID string) {
+	ot.registrations.remove(ID)
+}
+
 // Stop closes the "done" field's channel.
 func (ot *OneTime) Stop() {
 	close(ot.done)
@@ -161,9 +309,16 @@ func (ot *OneTime) Stop() {
 // RunJobAt wait until the Stop() function has been called on the run
 // or the specified time for the run is after the present time.
 func (ot *OneTime) RunJobAt(initr models.Initiator, job models.JobSpec) {
+	ot.runJobAt(initr, job, ot.registrations.generation(job.ID))
+}
+
+func (ot *OneTime) runJobAt(initr models.Initiator, job models.JobSpec, generation uint64) {
 	select {
 	case <-ot.done:
 	case <-ot.Clock.After(initr.Time.DurationFromNow()):
+		if !ot.registrations.current(job.ID, generation) {
+			return
+		}
 		if err := ot.Store.MarkRan(&initr); err != nil {
 			logger.Error(err.Error())
 			return
@@ -179,6 +334,170 @@ func (ot *OneTime) RunJobAt(initr models.Initiator, job models.JobSpec) {
 	}
 }
 
+// BlockInterval runs jobs with "interval" initiators that trigger every
+// number of blocks. It is attached to the HeadTracker, and runs a job every
+// number of blocks after the block it last ran the job at, as persisted in
+// the store, or on the first head it sees if it never ran the job.
+type BlockInterval struct {
+	store   *store.Store
+	mutex   sync.Mutex
+	entries map[string][]blockIntervalEntry
+}
+
+type blockIntervalEntry struct {
+	job   models.JobSpec
+	initr models.Initiator
+	last  uint64
+}
+
+// NewBlockInterval returns a BlockInterval for the store.
+func NewBlockInterval(store *store.Store) *BlockInterval {
+	return &BlockInterval{
+		store:   store,
+		entries: map[string][]blockIntervalEntry{},
+	}
+}
+
+// AddJob looks for block "interval" initiators, replacing any added for an
+// earlier version of the job.
+func (bi *BlockInterval) AddJob(job models.JobSpec) {
+	entries := []blockIntervalEntry{}
+	for _, initr := range job.InitiatorsFor(models.InitiatorInterval) {
+		if !initr.IsBlockInterval() {
+			continue
+		}
+		state, err := bi.store.FindInitiatorState(initr.ID)
+		if err != nil {
+			logger.Errorw("Unable to load block interval initiator state", "job", job.ID, "initiator", initr.ID, "error", err)
+			continue
+		}
+		entries = append(entries, blockIntervalEntry{job: job, initr: initr, last: state.LastFiredBlock})
+	}
+
+	bi.mutex.Lock()
+	defer bi.mutex.Unlock()
+	if len(entries) == 0 {
+		delete(bi.entries, job.ID)
+		return
+	}
+	bi.entries[job.ID] = entries
+}
+
+// RemoveJob stops the block "interval" initiators of the job from running.
+func (bi *BlockInterval) RemoveJob(ID string) {
+	bi.mutex.Lock()
+	defer bi.mutex.Unlock()
+	delete(bi.entries, ID)
+}
+
+// Stop removes every job, until they are added again.
+func (bi *BlockInterval) Stop() {
+	bi.mutex.Lock()
+	defer bi.mutex.Unlock()
+	bi.entries = map[string][]blockIntervalEntry{}
+}
+
+// Connect is a noop, blocks are counted on new heads.
+func (bi *BlockInterval) Connect(*models.IndexableBlockNumber) error {
+	return nil
+}
+
+// Disconnect is a noop.
+func (bi *BlockInterval) Disconnect() {}
+
+// OnNewHead runs the jobs whose block "interval" initiators are due at the
+// head's block number.
+func (bi *BlockInterval) OnNewHead(head *models.BlockHeader) {
+	height := head.ToIndexableBlockNumber().Number
+	number := height.ToInt().Uint64()
+	for _, entry := range bi.due(number) {
+		bi.recordFire(entry.initr, number)
+		_, err := ExecuteJob(entry.job, entry.initr, models.RunResult{}, &height, bi.store)
+		if err != nil && !expectedRecurringScheduleJobError(err) {
+			logger.Errorw(err.Error())
+		}
+	}
+}
+
+// recordFire persists the block the initiator last fired at, so that it
+// keeps counting from there when the job is added again.
+func (bi *BlockInterval) recordFire(initr models.Initiator, number uint64) {
+	state, err := bi.store.FindInitiatorState(initr.ID)
+	if err != nil {
+		logger.Errorw("Unable to load block interval initiator state", "initiator", initr.ID, "error", err)
+		return
+	}
+	state.LastFiredBlock = number
+	if err := bi.store.SaveInitiatorState(&state); err != nil {
+		logger.Errorw("Unable to record block interval initiator fire block", "initiator", initr.ID, "error", err)
+	}
+}
+
+func (bi *BlockInterval) due(number uint64) []blockIntervalEntry {
+	bi.mutex.Lock()
+	defer bi.mutex.Unlock()
+
+	due := []blockIntervalEntry{}
+	for _, entries := range bi.entries {
+		for i := range entries {
+			entry := &entries[i]
+			if entry.last != 0 && number < entry.last+entry.initr.Blocks {
+				continue
+			}
+			entry.last = number
+			due = append(due, *entry)
+		}
+	}
+	return due
+}
+
+// jobRegistrations tracks the latest time each job was added and which jobs
+// have been removed, so that cron functions and timers set up for an earlier
+// version of a job, or for a job that has since been removed, no longer run it.
+type jobRegistrations struct {
+	mutex       sync.Mutex
+	last        uint64
+	generations map[string]uint64
+	removed     map[string]bool
+}
+
+func (jr *jobRegistrations) register(jobID string) uint64 {
+	jr.mutex.Lock()
+	defer jr.mutex.Unlock()
+	jr.init()
+	jr.last++
+	jr.generations[jobID] = jr.last
+	delete(jr.removed, jobID)
+	return jr.last
+}
+
+func (jr *jobRegistrations) remove(jobID string) {
+	jr.mutex.Lock()
+	defer jr.mutex.Unlock()
+	jr.init()
+	delete(jr.generations, jobID)
+	jr.removed[jobID] = true
+}
+
+func (jr *jobRegistrations) init() {
+	if jr.generations == nil {
+		jr.generations = map[string]uint64{}
+		jr.removed = map[string]bool{}
+	}
+}
+
+func (jr *jobRegistrations) generation(jobID string) uint64 {
+	jr.mutex.Lock()
+	defer jr.mutex.Unlock()
+	return jr.generations[jobID]
+}
+
+func (jr *jobRegistrations) current(jobID string, generation uint64) bool {
+	jr.mutex.Lock()
+	defer jr.mutex.Unlock()
+	return !jr.removed[jobID] && jr.generations[jobID] == generation
+}
+
 func expectedRecurringScheduleJobError(err error) bool {
 	switch err.(type) {
 	case RecurringScheduleJobError:
@@ -195,19 +514,102 @@ type Cron interface {
 	Start()
 	Stop()
 	AddFunc(string, func()) error
+	Schedule(cron.Schedule, cron.Job) CronEntryID
+	Remove(...CronEntryID)
 }
 
+// CronEntryID identifies a function scheduled with Cron, so that it can be
+// removed again.
+type CronEntryID uint64
+
+// chainlinkCron keeps track of the functions it schedules, as the underlying
+// cron cannot remove them. Removing an entry replaces the underlying cron
+// with one that schedules only the remaining entries.
 type chainlinkCron struct {
-	*cron.Cron
+	mutex    sync.Mutex
+	cron     *cron.Cron
+	started  bool
+	lastID   CronEntryID
+	entries  map[CronEntryID]chainlinkCronEntry
+	replaced sync.WaitGroup
+}
+
+type chainlinkCronEntry struct {
+	schedule cron.Schedule
+	job      cron.Job
 }
 
 func newChainlinkCron() *chainlinkCron {
-	return &chainlinkCron{cron.New()}
+	return &chainlinkCron{
+		cron:    cron.New(),
+		entries: map[CronEntryID]chainlinkCronEntry{},
+	}
 }
 
+func (cc *chainlinkCron) Start() {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	cc.started = true
+	cc.cron.Start()
+}
+
+// Stop stops the cron and waits for running functions to finish, including
+// those started before an entry was removed.
 func (cc *chainlinkCron) Stop() {
-	cc.Cron.Stop()
-	cc.Cron.Wait()
+	cc.mutex.Lock()
+	cc.started = false
+	cc.cron.Stop()
+	cc.cron.Wait()
+	cc.mutex.Unlock()
+	cc.replaced.Wait()
+}
+
+func (cc *chainlinkCron) AddFunc(spec string, cmd func()) error {
+	schedule, err := cron.Parse(spec)
+	if err != nil {
+		return err
+	}
+	cc.Schedule(schedule, cron.FuncJob(cmd))
+	return nil
+}
+
+func (cc *chainlinkCron) Schedule(schedule cron.Schedule, job cron.Job) CronEntryID {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	cc.lastID++
+	cc.entries[cc.lastID] = chainlinkCronEntry{schedule: schedule, job: job}
+	cc.cron.Schedule(schedule, job)
+	return cc.lastID
+}
+
+func (cc *chainlinkCron) Remove(ids ...CronEntryID) {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	removed := false
+	for _, id := range ids {
+		if _, ok := cc.entries[id]; ok {
+			delete(cc.entries, id)
+			removed = true
+		}
+	}
+	if !removed {
+		return
+	}
+
+	old := cc.cron
+	cc.cron = cron.New()
+	for _, entry := range cc.entries {
+		cc.cron.Schedule(entry.schedule, entry.job)
+	}
+	if cc.started {
+		old.Stop()
+		cc.cron.Start()
+		cc.replaced.Add(1)
+		go func() {
+			defer cc.replaced.Done()
+			old.Wait()
+		}()
+	}
 }
 
 // Nower is an interface that fulfills the Now method,
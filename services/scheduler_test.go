@@ -1,14 +1,17 @@
 package services_test
 
 import (
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/mrwonko/cron"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/services"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tevino/abool"
 	"go.uber.org/zap/zapcore"
 	null "gopkg.in/guregu/null.v3"
@@ -124,6 +127,213 @@ func TestRecurring_AddJob(t *testing.T) {
 	}
 }
 
+func TestRecurring_RemoveJob(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	r := services.NewRecurring(store)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+	defer r.Stop()
+
+	removed, _ := cltest.NewJobWithSchedule("* * * * *")
+	assert.Nil(t, store.SaveJob(&removed))
+	kept, _ := cltest.NewJobWithSchedule("* * * * *")
+	assert.Nil(t, store.SaveJob(&kept))
+
+	r.AddJob(removed)
+	r.AddJob(kept)
+	r.RemoveJob(removed.ID)
+	assert.Equal(t, 1, len(cron.Entries), "expected the removed job's entry to be removed from cron")
+	cron.RunEntries()
+
+	cltest.WaitForRuns(t, removed, store, 0)
+	cltest.WaitForRuns(t, kept, store, 1)
+}
+
+func TestChainlinkCron_Remove(t *testing.T) {
+	t.Parallel()
+
+	c := services.ExportedNewChainlinkCron()
+	var removedCount, keptCount int32
+	removed := c.Schedule(cron.Every(time.Second), cron.FuncJob(func() {
+		atomic.AddInt32(&removedCount, 1)
+	}))
+	c.Schedule(cron.Every(time.Second), cron.FuncJob(func() {
+		atomic.AddInt32(&keptCount, 1)
+	}))
+	c.Start()
+	defer c.Stop()
+
+	c.Remove(removed)
+	gomega.NewGomegaWithT(t).Eventually(func() int32 {
+		return atomic.LoadInt32(&keptCount)
+	}, 3*time.Second).Should(gomega.BeNumerically(">", 0))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&removedCount))
+}
+
+func TestRecurring_AddJob_ReplacesEarlierRegistration(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	r := services.NewRecurring(store)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+	defer r.Stop()
+
+	j, _ := cltest.NewJobWithSchedule("* * * * *")
+	assert.Nil(t, store.SaveJob(&j))
+
+	r.AddJob(j)
+	r.RemoveJob(j.ID)
+	r.AddJob(j)
+	r.AddJob(j)
+	assert.Equal(t, 1, len(cron.Entries))
+	cron.RunEntries()
+
+	cltest.WaitForRuns(t, j, store, 1)
+}
+
+func TestRecurring_AddJob_TimeZone(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	r := services.NewRecurring(store)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+	defer r.Stop()
+
+	j, _ := cltest.NewJobWithSchedule("0 0 9 * * *")
+	j.Initiators[0].TimeZone = "America/New_York"
+	r.AddJob(j)
+
+	require.Equal(t, 1, len(cron.Entries))
+	next := cron.Entries[0].CronSchedule.Next(cltest.ParseISO8601("2019-01-01T00:00:00.000Z"))
+	assert.Equal(t, cltest.ParseISO8601("2019-01-01T14:00:00.000Z").Unix(), next.Unix())
+}
+
+func TestRecurring_AddJob_CatchUp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		catchUp  string
+		wantRuns int
+	}{
+		{"", 0},
+		{models.CatchUpSkip, 0},
+		{models.CatchUpOnce, 1},
+		{models.CatchUpAll, 3},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.catchUp, func(t *testing.T) {
+			store, cleanup := cltest.NewStore()
+			defer cleanup()
+			clock := cltest.UseSettableClock(store)
+			now := cltest.ParseISO8601("2019-01-01T12:30:00.000Z")
+			clock.SetTime(now)
+
+			r := services.NewRecurring(store)
+			r.Cron = cltest.NewMockCron()
+			defer r.Stop()
+
+			j, _ := cltest.NewJobWithSchedule("0 0 * * * *")
+			j.Initiators[0].CatchUp = test.catchUp
+			require.NoError(t, store.SaveJob(&j))
+			initr := j.Initiators[0]
+			state := models.InitiatorState{
+				InitiatorID: initr.ID,
+				LastFiredAt: cltest.NullableTime(now.Add(-3 * time.Hour)),
+			}
+			require.NoError(t, store.SaveInitiatorState(&state))
+
+			r.AddJob(j)
+
+			cltest.WaitForRuns(t, j, store, test.wantRuns)
+			if test.wantRuns > 0 {
+				gomega.NewGomegaWithT(t).Eventually(func() int64 {
+					persisted, err := store.FindInitiatorState(initr.ID)
+					require.NoError(t, err)
+					return persisted.LastFiredAt.Time.Unix()
+				}).Should(gomega.Equal(now.Unix()))
+			}
+		})
+	}
+}
+
+func TestRecurring_AddJob_Interval(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	r := services.NewRecurring(store)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+	defer r.Stop()
+
+	timed, _ := cltest.NewJobWithIntervalInitiator(30*time.Second, 0)
+	r.AddJob(timed)
+	blocks, _ := cltest.NewJobWithIntervalInitiator(0, 10)
+	r.AddJob(blocks)
+
+	require.Equal(t, 1, len(cron.Entries))
+	from := cltest.ParseISO8601("2019-01-01T00:00:00.000Z")
+	assert.Equal(t, from.Add(30*time.Second), cron.Entries[0].CronSchedule.Next(from))
+
+	cron.RunEntries()
+	cltest.WaitForRuns(t, timed, store, 1)
+}
+
+func TestBlockInterval_OnNewHead(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	bi := services.NewBlockInterval(store)
+	j, _ := cltest.NewJobWithIntervalInitiator(0, 10)
+	require.NoError(t, store.SaveJob(&j))
+	timed, _ := cltest.NewJobWithIntervalInitiator(30*time.Second, 0)
+	require.NoError(t, store.SaveJob(&timed))
+	bi.AddJob(j)
+	bi.AddJob(timed)
+
+	bi.OnNewHead(cltest.NewBlockHeader(100))
+	bi.OnNewHead(cltest.NewBlockHeader(105))
+	cltest.WaitForRuns(t, j, store, 1)
+
+	bi.OnNewHead(cltest.NewBlockHeader(110))
+	cltest.WaitForRuns(t, j, store, 2)
+	runs, err := store.JobRunsFor(j.ID)
+	require.NoError(t, err)
+	heights := []int64{runs[0].CreationHeight.ToInt().Int64(), runs[1].CreationHeight.ToInt().Int64()}
+	assert.ElementsMatch(t, []int64{100, 110}, heights)
+
+	bi.RemoveJob(j.ID)
+	bi.OnNewHead(cltest.NewBlockHeader(120))
+	cltest.WaitForRuns(t, j, store, 2)
+	cltest.WaitForRuns(t, timed, store, 0)
+
+	state, err := store.FindInitiatorState(j.Initiators[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(110), state.LastFiredBlock)
+
+	restarted := services.NewBlockInterval(store)
+	restarted.AddJob(j)
+	restarted.OnNewHead(cltest.NewBlockHeader(115))
+	cltest.WaitForRuns(t, j, store, 2)
+	restarted.OnNewHead(cltest.NewBlockHeader(120))
+	cltest.WaitForRuns(t, j, store, 3)
+}
+
 func TestOneTime_AddJob(t *testing.T) {
 	nullTime := cltest.NullTime(nil)
 	pastTime := cltest.NullTime("2000-01-01T00:00:00.000Z")
@@ -209,6 +419,39 @@ func TestOneTime_RunJobAt_StopJobBeforeExecution(t *testing.T) {
 	assert.Equal(t, 0, len(jobRuns))
 }
 
+func TestOneTime_RemoveJob(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	ot := services.OneTime{
+		Clock: store.Clock,
+		Store: store,
+	}
+	ot.Start()
+	defer ot.Stop()
+	j, initr := cltest.NewJobWithRunAtInitiator(time.Now().Add(time.Hour * -1))
+	assert.Nil(t, store.SaveJob(&j))
+	initr.ID = j.Initiators[0].ID
+	initr.JobID = j.ID
+
+	ot.RemoveJob(j.ID)
+
+	finished := abool.New()
+	go func() {
+		ot.RunJobAt(initr, j)
+		finished.Set()
+	}()
+
+	gomega.NewGomegaWithT(t).Eventually(func() bool {
+		return finished.IsSet()
+	}).Should(gomega.Equal(true))
+	jobRuns, err := store.JobRunsFor(j.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(jobRuns))
+}
+
 func TestOneTime_RunJobAt_ExecuteLateJob(t *testing.T) {
 	t.Parallel()
 
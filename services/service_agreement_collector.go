@@ -0,0 +1,96 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// serviceAgreementCollectorTimeout bounds each request to another node.
+const serviceAgreementCollectorTimeout = 30 * time.Second
+
+// ServiceAgreementCollector assembles a multi-oracle service agreement by
+// submitting the agreement request to each participating node and combining
+// their signatures.
+type ServiceAgreementCollector struct {
+	client *http.Client
+}
+
+// NewServiceAgreementCollector returns a ServiceAgreementCollector using a
+// default HTTP client.
+func NewServiceAgreementCollector() *ServiceAgreementCollector {
+	return &ServiceAgreementCollector{
+		client: &http.Client{Timeout: serviceAgreementCollectorTimeout},
+	}
+}
+
+// Collect submits the agreement request to every node, checks that each
+// returned signature was made by one of the agreement's oracles, and returns
+// the arguments for the Coordinator contract once every oracle has signed.
+func (sac *ServiceAgreementCollector) Collect(
+	request []byte,
+	nodes []models.WebURL,
+) (models.CoordinatorServiceAgreement, error) {
+	us, err := models.NewUnsignedServiceAgreementFromRequest(bytes.NewReader(request))
+	if err != nil {
+		return models.CoordinatorServiceAgreement{}, err
+	}
+	if len(nodes) == 0 {
+		return models.CoordinatorServiceAgreement{}, fmt.Errorf("no nodes to collect signatures from")
+	}
+
+	signatures := map[common.Address]models.Signature{}
+	for _, node := range nodes {
+		nodeURL := url.URL(node)
+		sa, err := sac.submit(nodeURL, request)
+		if err != nil {
+			return models.CoordinatorServiceAgreement{}, fmt.Errorf("node %s: %v", nodeURL.String(), err)
+		}
+		if sa.ID != us.ID.String() {
+			return models.CoordinatorServiceAgreement{}, fmt.Errorf(
+				"node %s: signed agreement %s instead of %s", nodeURL.String(), sa.ID, us.ID.String())
+		}
+
+		signer, err := us.RecoverSigner(sa.Signature)
+		if err != nil {
+			return models.CoordinatorServiceAgreement{}, fmt.Errorf("node %s: invalid signature: %v", nodeURL.String(), err)
+		} else if !us.Encumbrance.HasOracle(signer) {
+			return models.CoordinatorServiceAgreement{}, fmt.Errorf(
+				"node %s: signed with %s, which is not one of the agreement's oracles", nodeURL.String(), signer.Hex())
+		}
+		signatures[signer] = sa.Signature
+	}
+
+	return models.NewCoordinatorServiceAgreement(us, signatures)
+}
+
+func (sac *ServiceAgreementCollector) submit(node url.URL, request []byte) (models.ServiceAgreement, error) {
+	node.Path = path.Join(node.Path, "/v2/service_agreements")
+	resp, err := sac.client.Post(node.String(), "application/json", bytes.NewReader(request))
+	if err != nil {
+		return models.ServiceAgreement{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return models.ServiceAgreement{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return models.ServiceAgreement{}, fmt.Errorf("unexpected response %d: %s", resp.StatusCode, body)
+	}
+
+	var sa models.ServiceAgreement
+	if err := jsonapi.Unmarshal(body, &sa); err != nil {
+		return models.ServiceAgreement{}, fmt.Errorf("unable to parse agreement: %v", err)
+	}
+	return sa, nil
+}
@@ -0,0 +1,106 @@
+package services_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSigningNode(t *testing.T, key *ecdsa.PrivateKey) (models.WebURL, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/service_agreements", r.URL.Path)
+		us, err := models.NewUnsignedServiceAgreementFromRequest(r.Body)
+		require.NoError(t, err)
+		sa, err := models.BuildServiceAgreement(us, cltest.KeySigner{Key: key})
+		require.NoError(t, err)
+		body, err := jsonapi.Marshal(sa)
+		require.NoError(t, err)
+		w.Write(body)
+	}))
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return models.WebURL(*u), server.Close
+}
+
+func agreementRequest(oracles ...common.Address) []byte {
+	var hexes []string
+	for _, oracle := range oracles {
+		hexes = append(hexes, fmt.Sprintf(`"%s"`, oracle.Hex()))
+	}
+	return []byte(fmt.Sprintf(`{
+		"initiators":[{"type":"execagreement"}],
+		"tasks":[{"type":"NoOp"}],
+		"payment":"1000000000000000000",
+		"expiration":300,
+		"endAt":"2019-10-19T22:17:19Z",
+		"oracles":[%s]
+	}`, strings.Join(hexes, ",")))
+}
+
+func TestServiceAgreementCollector_Collect(t *testing.T) {
+	t.Parallel()
+
+	key1, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	outsider, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	oracle1 := crypto.PubkeyToAddress(key1.PublicKey)
+	oracle2 := crypto.PubkeyToAddress(key2.PublicKey)
+
+	node1, cleanup1 := newSigningNode(t, key1)
+	defer cleanup1()
+	node2, cleanup2 := newSigningNode(t, key2)
+	defer cleanup2()
+	outsiderNode, cleanup3 := newSigningNode(t, outsider)
+	defer cleanup3()
+
+	tests := []struct {
+		name    string
+		nodes   []models.WebURL
+		wantErr bool
+	}{
+		{"all oracles sign", []models.WebURL{node2, node1}, false},
+		{"signer is not an oracle", []models.WebURL{node1, node2, outsiderNode}, true},
+		{"oracle missing", []models.WebURL{node1}, true},
+		{"no nodes", []models.WebURL{}, true},
+	}
+
+	request := agreementRequest(oracle1, oracle2)
+	us, err := models.NewUnsignedServiceAgreementFromRequest(bytes.NewReader(request))
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			csa, err := services.NewServiceAgreementCollector().Collect(request, test.nodes)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, us.ID, csa.ID)
+			assert.Equal(t, us.RequestDigest, csa.RequestDigest)
+			assert.Equal(t, []common.Address{oracle1, oracle2}, csa.Oracles)
+			require.Len(t, csa.Vs, 2)
+			for _, v := range csa.Vs {
+				assert.Contains(t, []uint64{27, 28}, v)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// NewServiceAgreementTopic is the signature for the
+// Coordinator.NewServiceAgreement(...) event, emitted when an agreement is
+// initiated on-chain. See
+// https://github.com/smartcontractkit/chainlink/blob/master/solidity/contracts/Coordinator.sol#NewServiceAgreement
+var NewServiceAgreementTopic = mustHash("NewServiceAgreement(bytes32,bytes32)")
+
+// ServiceAgreementMonitor follows the lifecycle of service agreements on new
+// heads: it records when pending agreements are initiated on the Coordinator
+// contract, and stops listening for runs of agreements that have expired.
+type ServiceAgreementMonitor struct {
+	store      *store.Store
+	subscriber JobSubscriber
+	mutex      sync.Mutex
+}
+
+// NewServiceAgreementMonitor returns a ServiceAgreementMonitor that stops
+// expired agreements' jobs on the passed JobSubscriber.
+func NewServiceAgreementMonitor(store *store.Store, subscriber JobSubscriber) *ServiceAgreementMonitor {
+	return &ServiceAgreementMonitor{store: store, subscriber: subscriber}
+}
+
+// Connect looks for agreements initiated while the node was offline.
+func (sam *ServiceAgreementMonitor) Connect(head *models.IndexableBlockNumber) error {
+	if head == nil {
+		return nil
+	}
+	if err := sam.CheckInitiations(head.ToInt()); err != nil {
+		logger.Errorw("Error checking service agreement initiations", "error", err)
+	}
+	return nil
+}
+
+// Disconnect is a noop.
+func (sam *ServiceAgreementMonitor) Disconnect() {}
+
+// OnNewHead checks for newly initiated and expired agreements.
+func (sam *ServiceAgreementMonitor) OnNewHead(head *models.BlockHeader) {
+	if err := sam.CheckInitiations(head.Number.ToInt()); err != nil {
+		logger.Errorw("Error checking service agreement initiations", "error", err)
+	}
+	sam.StopExpired()
+}
+
+// CheckInitiations searches the blocks up to blockNumber for the
+// NewServiceAgreement events of pending agreements, recording those found.
+// The last block searched is persisted, so later checks, including those
+// after a restart, only cover the blocks since. The first check ever starts
+// from the earliest block a pending agreement was created at.
+func (sam *ServiceAgreementMonitor) CheckInitiations(blockNumber *big.Int) error {
+	sam.mutex.Lock()
+	defer sam.mutex.Unlock()
+
+	sas, err := sam.store.UninitiatedServiceAgreements()
+	if err != nil {
+		return err
+	}
+
+	now := sam.store.Clock.Now()
+	pending := map[common.Hash]models.ServiceAgreement{}
+	ids := []common.Hash{}
+	earliest := blockNumber.Uint64()
+	for _, sa := range sas {
+		if sa.Expired(now) {
+			continue
+		} else if coordinator(sa) == nil {
+			logger.Debugw(fmt.Sprintf("Service agreement %s names no Coordinator address, its initiation cannot be verified", sa.ID))
+			continue
+		}
+		id := common.HexToHash(sa.ID)
+		pending[id] = sa
+		ids = append(ids, id)
+		if sa.CreatedBlockNumber < earliest {
+			earliest = sa.CreatedBlockNumber
+		}
+	}
+
+	from := new(big.Int).SetUint64(earliest)
+	checked, err := sam.store.ServiceAgreementsCheckedBlock()
+	if err != nil {
+		return err
+	} else if checked != nil {
+		from = new(big.Int).SetUint64(*checked + 1)
+	}
+	if len(ids) == 0 || from.Cmp(blockNumber) > 0 {
+		return sam.store.SetServiceAgreementsCheckedBlock(blockNumber.Uint64())
+	}
+
+	logs, err := sam.store.TxManager.GetLogs(ethereum.FilterQuery{
+		FromBlock: from,
+		ToBlock:   blockNumber,
+		Topics:    [][]common.Hash{{NewServiceAgreementTopic}, ids},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, log := range logs {
+		if len(log.Topics) < 2 {
+			continue
+		}
+		sa, ok := pending[log.Topics[1]]
+		if !ok || *coordinator(sa) != log.Address {
+			continue
+		}
+		if err := sam.store.MarkServiceAgreementInitiated(sa.ID, log.TxHash, log.BlockNumber); err != nil {
+			return err
+		}
+		delete(pending, log.Topics[1])
+		logger.Infow(
+			fmt.Sprintf("Service agreement %s initiated on-chain", sa.ID),
+			"txHash", log.TxHash.Hex(),
+			"blockNumber", log.BlockNumber,
+		)
+	}
+
+	return sam.store.SetServiceAgreementsCheckedBlock(blockNumber.Uint64())
+}
+
+// coordinator returns the address of the Coordinator contract the
+// agreement's job listens to, or nil if it names none. Events from any other
+// contract are rejected.
+func coordinator(sa models.ServiceAgreement) *common.Address {
+	for _, initr := range sa.JobSpec.InitiatorsFor(models.InitiatorServiceAgreementExecutionLog) {
+		if initr.Address != (common.Address{}) {
+			return &initr.Address
+		}
+	}
+	return nil
+}
+
+// StopExpired unsubscribes the jobs of agreements whose endAt has passed, so
+// that the node stops listening for their runs.
+func (sam *ServiceAgreementMonitor) StopExpired() {
+	now := sam.store.Clock.Now()
+	for _, job := range sam.subscriber.Jobs() {
+		if len(job.InitiatorsFor(models.InitiatorServiceAgreementExecutionLog)) == 0 || !job.Ended(now) {
+			continue
+		}
+		if err := sam.subscriber.RemoveJob(job.ID); err != nil {
+			logger.Errorw("Error stopping job of expired service agreement", "job", job.ID, "error", err)
+			continue
+		}
+		logger.Infow(fmt.Sprintf("Service agreement for job %s has expired, no longer listening for runs", job.ID), "endAt", job.EndAt.Time)
+	}
+}
@@ -0,0 +1,113 @@
+package services_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/internal/mocks"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/services/mock_services"
+	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v3"
+)
+
+func newServiceAgreement(t *testing.T, initiator string, payment int) models.ServiceAgreement {
+	endAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	sa, err := cltest.ServiceAgreementFromString(fmt.Sprintf(
+		`{"initiators":[%s],"tasks":[{"type":"NoOp"}],"payment":"%d","endAt":"%s"}`,
+		initiator, payment, endAt))
+	require.NoError(t, err)
+	return sa
+}
+
+func TestServiceAgreementMonitor_CheckInitiations(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	txm := mocks.NewMockTxManager(ctrl)
+	store.TxManager = txm
+
+	coordinator := cltest.NewAddress()
+	initiator := fmt.Sprintf(`{"type":"execagreement","address":"%s"}`, coordinator.Hex())
+	initiated := newServiceAgreement(t, initiator, 1)
+	initiated.CreatedBlockNumber = 8
+	require.NoError(t, store.SaveServiceAgreement(&initiated))
+	spoofed := newServiceAgreement(t, initiator, 2)
+	spoofed.CreatedBlockNumber = 6
+	require.NoError(t, store.SaveServiceAgreement(&spoofed))
+	unaddressed := newServiceAgreement(t, `{"type":"execagreement"}`, 3)
+	require.NoError(t, store.SaveServiceAgreement(&unaddressed))
+
+	txHash := cltest.NewHash()
+	txm.EXPECT().GetLogs(gomock.Any()).Do(func(q ethereum.FilterQuery) {
+		assert.Equal(t, big.NewInt(6), q.FromBlock, "expected the first check to start from the earliest agreement")
+		assert.Equal(t, big.NewInt(10), q.ToBlock)
+		assert.ElementsMatch(t, []common.Hash{common.HexToHash(initiated.ID), common.HexToHash(spoofed.ID)}, q.Topics[1])
+	}).Return([]strpkg.Log{
+		{
+			Address:     coordinator,
+			Topics:      []common.Hash{services.NewServiceAgreementTopic, common.HexToHash(initiated.ID), cltest.NewHash()},
+			TxHash:      txHash,
+			BlockNumber: 10,
+		},
+		{
+			Address: cltest.NewAddress(),
+			Topics:  []common.Hash{services.NewServiceAgreementTopic, common.HexToHash(spoofed.ID), cltest.NewHash()},
+		},
+	}, nil)
+
+	monitor := services.NewServiceAgreementMonitor(store, services.NewJobSubscriber(store))
+	require.NoError(t, monitor.CheckInitiations(big.NewInt(10)))
+
+	sa, err := store.FindServiceAgreement(initiated.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txHash, sa.InitiatedTxHash)
+	assert.Equal(t, uint64(10), sa.InitiatedBlockNumber)
+	sa, err = store.FindServiceAgreement(spoofed.ID)
+	require.NoError(t, err)
+	assert.False(t, sa.Initiated(), "expected events from other contracts to be ignored")
+
+	txm.EXPECT().GetLogs(ethereum.FilterQuery{
+		FromBlock: big.NewInt(11),
+		ToBlock:   big.NewInt(12),
+		Topics:    [][]common.Hash{{services.NewServiceAgreementTopic}, {common.HexToHash(spoofed.ID)}},
+	}).Return([]strpkg.Log{}, nil)
+	restarted := services.NewServiceAgreementMonitor(store, services.NewJobSubscriber(store))
+	require.NoError(t, restarted.CheckInitiations(big.NewInt(12)))
+}
+
+func TestServiceAgreementMonitor_StopExpired(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	clock := cltest.UseSettableClock(store)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	subscriber := mock_services.NewMockJobSubscriber(ctrl)
+
+	endAt := time.Now()
+	expired, _ := cltest.NewJobWithSALogInitiator()
+	expired.EndAt = null.TimeFrom(endAt)
+	running, _ := cltest.NewJobWithSALogInitiator()
+	running.EndAt = null.TimeFrom(endAt.Add(time.Hour))
+	other, _ := cltest.NewJobWithLogInitiator()
+	other.EndAt = null.TimeFrom(endAt)
+	subscriber.EXPECT().Jobs().Return([]models.JobSpec{expired, running, other})
+	subscriber.EXPECT().RemoveJob(expired.ID).Return(nil)
+
+	clock.SetTime(endAt.Add(time.Minute))
+	services.NewServiceAgreementMonitor(store, subscriber).StopExpired()
+}
@@ -272,10 +272,19 @@ func runJob(le InitiatorSubscriptionLogEvent, data models.JSON, initr models.Ini
 		err = fmt.Errorf("Run Log didn't have have a valid requester: %v", le.Requester().Hex())
 		input = input.WithError(err)
 		logger.Errorw(err.Error(), le.ForLogger()...)
+	} else if err = le.validateAgreedPayment(payment); err != nil {
+		input = input.WithError(err)
+		logger.Errorw(err.Error(), le.ForLogger()...)
+	}
+
+	var requester *common.Address
+	if isRunLog(le.Log) {
+		address := le.Requester()
+		requester = &address
 	}
 
 	currentHead := le.ToIndexableBlockNumber().Number
-	_, err = ExecuteJob(le.Job, initr, input, &currentHead, le.store)
+	_, err = executeJob(le.Job, initr, input, &currentHead, requester, le.store)
 	if err != nil {
 		logger.Errorw(err.Error(), le.ForLogger()...)
 	}
@@ -426,6 +435,28 @@ func (le InitiatorSubscriptionLogEvent) validRequester() bool {
 	return false
 }
 
+// validateAgreedPayment returns an error if the log is a
+// ServiceAgreementExecutionLog paying less than its agreement's encumbrance.
+func (le InitiatorSubscriptionLogEvent) validateAgreedPayment(payment *assets.Link) error {
+	if le.Log.Topics[0] != ServiceAgreementExecutionLogTopic {
+		return nil
+	}
+
+	sa, err := le.store.FindServiceAgreementForJob(le.Job.ID)
+	if err != nil {
+		return fmt.Errorf("Service agreement payment error: unable to find the agreement for job %v: %v", le.Job.ID, err)
+	}
+	agreed := sa.Encumbrance.Payment
+	if agreed == nil {
+		return nil
+	} else if payment == nil {
+		return fmt.Errorf("Service agreement payment error: run carried no payment, agreed payment is %v", agreed)
+	} else if payment.Cmp(agreed) < 0 {
+		return fmt.Errorf("Service agreement payment error: run paid %v, below the agreed payment of %v", payment, agreed)
+	}
+	return nil
+}
+
 // RunLogJSON extracts data from the log's topics and data specific to the format defined
 // by RunLogs.
 func (le InitiatorSubscriptionLogEvent) RunLogJSON() (models.JSON, error) {
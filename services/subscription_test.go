@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/services"
 	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -320,6 +321,14 @@ func TestStartRunOrSALogSubscription_ValidateSenders(t *testing.T) {
 
 				js, initr := logFuncs.jobConstructor()
 				initr.Requesters = []common.Address{requester}
+				if initr.Type == models.InitiatorServiceAgreementExecutionLog {
+					sa := models.ServiceAgreement{
+						ID:          cltest.NewHash().Hex(),
+						JobSpec:     js,
+						Encumbrance: models.Encumbrance{Payment: app.Store.Config.MinimumContractPayment()},
+					}
+					require.NoError(t, app.Store.SaveServiceAgreement(&sa))
+				}
 				_, err := logFuncs.subscriber(initr, js, nil, app.Store)
 				assert.NoError(t, err)
 
@@ -336,6 +345,67 @@ func TestStartRunOrSALogSubscription_ValidateSenders(t *testing.T) {
 	}
 }
 
+func TestStartSALogSubscription_ValidatesAgreedPayment(t *testing.T) {
+	tests := []struct {
+		name    string
+		payment *assets.Link
+		saved   bool
+		status  models.RunStatus
+	}{
+		{"pays agreed amount", assets.NewLink(100), true, models.RunStatusCompleted},
+		{"pays less than agreed", assets.NewLink(101), true, models.RunStatusErrored},
+		{"no agreement", nil, false, models.RunStatusErrored},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			config, _ := cltest.NewConfigWithPrivateKey()
+			app, cleanup := cltest.NewApplicationWithConfigAndUnlockedAccount(config)
+			defer cleanup()
+
+			eth := app.MockEthClient()
+			logs := make(chan strpkg.Log, 1)
+			eth.Context("app.Start()", func(eth *cltest.EthMock) {
+				eth.Register("eth_getBlockByNumber", models.BlockHeader{})
+				eth.Register("eth_getTransactionCount", "0x1")
+				eth.RegisterSubscription("logs", logs)
+			})
+			assert.NoError(t, app.Start())
+
+			js, initr := cltest.NewJobWithSALogInitiator()
+			if test.saved {
+				sa := models.ServiceAgreement{
+					ID:          cltest.NewHash().Hex(),
+					JobSpec:     js,
+					Encumbrance: models.Encumbrance{Payment: test.payment},
+				}
+				require.NoError(t, app.Store.SaveServiceAgreement(&sa))
+			}
+			_, err := services.StartSALogSubscription(initr, js, nil, app.Store)
+			assert.NoError(t, err)
+
+			// The log pays the minimum contract payment of 100
+			logs <- cltest.NewServiceAgreementExecutionLog(js.ID, cltest.NewAddress(), cltest.NewAddress(), 1, `{}`)
+			eth.EventuallyAllCalled(t)
+
+			var run models.JobRun
+			gomega.NewGomegaWithT(t).Eventually(func() models.RunStatus {
+				runs, err := app.Store.JobRunsFor(js.ID)
+				require.NoError(t, err)
+				if len(runs) == 0 {
+					return ""
+				}
+				run = runs[0]
+				return run.Status
+			}).Should(gomega.Equal(test.status))
+			if test.status == models.RunStatusErrored {
+				assert.Contains(t, run.Result.Error(), "Service agreement payment error")
+			}
+		})
+	}
+}
+
 func TestRunTopic(t *testing.T) {
 	assert.Equal(t, common.HexToHash("0x6d6db1f8fe19d95b1d0fa6a4bce7bb24fbf84597b35a33ff95521fac453c1529"), services.RunLogTopic)
 }
@@ -2,6 +2,7 @@ package services
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -20,6 +21,14 @@ func ValidateJob(j models.JobSpec, store *store.Store) error {
 	if len(j.Initiators) < 1 || len(j.Tasks) < 1 {
 		fe.Add("Must have at least one Initiator and one Task")
 	}
+	if err := validateJobLabels(j); err != nil {
+		fe.Merge(err)
+	}
+	if j.Name != "" {
+		if existing, err := store.FindActiveJobByName(j.Name); err == nil && existing.ID != j.ID {
+			fe.Add(fmt.Sprintf("Job named %v already exists", j.Name))
+		}
+	}
 	for _, i := range j.Initiators {
 		if err := ValidateInitiator(i, j); err != nil {
 			fe.Merge(err)
@@ -33,6 +42,45 @@ func ValidateJob(j models.JobSpec, store *store.Store) error {
 	return fe.CoerceEmptyToNil()
 }
 
+const (
+	maxJobNameLength        = 64
+	maxJobDescriptionLength = 1000
+	maxJobTags              = 16
+	maxJobTagLength         = 32
+)
+
+var (
+	jobNameFormat = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9 ._-]*$`)
+	jobTagFormat  = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+)
+
+// validateJobLabels checks the optional name, description and tags of a job.
+func validateJobLabels(j models.JobSpec) error {
+	fe := models.NewJSONAPIErrors()
+	if j.Name != "" && !jobNameFormat.MatchString(j.Name) {
+		fe.Add("Name must start with a letter or digit and contain only letters, digits, spaces, '.', '_' or '-'")
+	}
+	if len(j.Name) > maxJobNameLength {
+		fe.Add(fmt.Sprintf("Name cannot be longer than %d characters", maxJobNameLength))
+	}
+	if len(j.Description) > maxJobDescriptionLength {
+		fe.Add(fmt.Sprintf("Description cannot be longer than %d characters", maxJobDescriptionLength))
+	}
+	if len(j.Tags) > maxJobTags {
+		fe.Add(fmt.Sprintf("Cannot have more than %d tags", maxJobTags))
+	}
+	seen := map[string]bool{}
+	for _, tag := range j.Tags {
+		if !jobTagFormat.MatchString(tag) || len(tag) > maxJobTagLength {
+			fe.Add(fmt.Sprintf("Tag %q must be at most %d lowercase letters, digits, '.', '_' or '-'", tag, maxJobTagLength))
+		} else if seen[tag] {
+			fe.Add(fmt.Sprintf("Tag %q is repeated", tag))
+		}
+		seen[tag] = true
+	}
+	return fe.CoerceEmptyToNil()
+}
+
 // ValidateAdapter checks that the bridge type doesn't have a duplicate or invalid name
 func ValidateAdapter(bt *models.BridgeType, store *store.Store) (err error) {
 	fe := models.NewJSONAPIErrors()
@@ -56,6 +104,8 @@ func ValidateInitiator(i models.Initiator, j models.JobSpec) error {
 		return validateRunAtInitiator(i, j)
 	case models.InitiatorCron:
 		return validateCronInitiator(i)
+	case models.InitiatorInterval:
+		return validateIntervalInitiator(i)
 	case models.InitiatorServiceAgreementExecutionLog:
 		return validateServiceAgreementInitiator(i, j)
 	case models.InitiatorWeb:
@@ -82,10 +132,34 @@ func validateRunAtInitiator(i models.Initiator, j models.JobSpec) error {
 }
 
 func validateCronInitiator(i models.Initiator) error {
+	fe := models.NewJSONAPIErrors()
 	if i.Schedule == "" {
-		return models.NewJSONAPIErrorsWith("Schedule must have a cron")
+		fe.Add("Schedule must have a cron")
 	}
-	return nil
+	if _, err := i.Location(); err != nil {
+		fe.Add(fmt.Sprintf("Time zone %v is not valid: %v", i.TimeZone, err))
+	}
+	if i.Jitter < 0 {
+		fe.Add("Jitter cannot be negative")
+	}
+	switch i.CatchUp {
+	case "", models.CatchUpSkip, models.CatchUpOnce, models.CatchUpAll:
+	default:
+		fe.Add(fmt.Sprintf("Catch up must be one of %v, %v or %v", models.CatchUpSkip, models.CatchUpOnce, models.CatchUpAll))
+	}
+	return fe.CoerceEmptyToNil()
+}
+
+func validateIntervalInitiator(i models.Initiator) error {
+	fe := models.NewJSONAPIErrors()
+	if i.Period == 0 && i.Blocks == 0 {
+		fe.Add("Interval must have a period or a number of blocks")
+	} else if i.Period != 0 && i.Blocks != 0 {
+		fe.Add("Interval cannot have both a period and a number of blocks")
+	} else if i.Blocks == 0 && i.Period.Duration() < time.Second {
+		fe.Add("Interval period must be at least 1s")
+	}
+	return fe.CoerceEmptyToNil()
 }
 
 func validateServiceAgreementInitiator(i models.Initiator, j models.JobSpec) error {
@@ -97,8 +171,27 @@ func validateServiceAgreementInitiator(i models.Initiator, j models.JobSpec) err
 }
 
 func validateTask(task models.TaskSpec, store *store.Store) error {
-	_, err := adapters.For(task, store)
-	return err
+	adapter, err := adapters.For(task, store)
+	if err != nil {
+		return err
+	}
+	switch typed := adapter.BaseAdapter.(type) {
+	case *adapters.Deviation:
+		return validateDeviationTask(*typed)
+	default:
+		return nil
+	}
+}
+
+func validateDeviationTask(d adapters.Deviation) error {
+	fe := models.NewJSONAPIErrors()
+	if d.Threshold < 0 {
+		fe.Add("Deviation threshold cannot be negative")
+	}
+	if d.Heartbeat < 0 {
+		fe.Add("Deviation heartbeat cannot be negative")
+	}
+	return fe.CoerceEmptyToNil()
 }
 
 // ValidateServiceAgreement checks the ServiceAgreement for any application logic errors.
@@ -116,21 +209,10 @@ func ValidateServiceAgreement(sa models.ServiceAgreement, store *store.Store) er
 		fe.Add(fmt.Sprintf("Service agreement encumbrance error: Expiration is below minimum %v", config.MinimumRequestExpiration()))
 	}
 
-	account, err := store.KeyStore.GetFirstAccount()
-	if err != nil {
+	if err := validateOracleMembership(sa.Encumbrance, store, fe); err != nil {
 		return err // 500
 	}
 
-	found := false
-	for _, oracle := range sa.Encumbrance.Oracles {
-		if oracle.Address() == account.Address {
-			found = true
-		}
-	}
-	if !found {
-		fe.Add("Service agreement encumbrance error: This node must be listed in the participating oracles")
-	}
-
 	if err := ValidateJob(sa.JobSpec, store); err != nil {
 		fe.Add(fmt.Sprintf("Service agreement job spec error: Job spec validation: %v", err))
 	}
@@ -151,3 +233,30 @@ func ValidateServiceAgreement(sa models.ServiceAgreement, store *store.Store) er
 
 	return fe.CoerceEmptyToNil()
 }
+
+// validateOracleMembership checks that the node's signing account is among
+// the encumbrance's oracles, distinguishing agreements listing none of the
+// node's accounts from those listing an account other than the signing one.
+func validateOracleMembership(encumbrance models.Encumbrance, store *store.Store, fe *models.JSONAPIErrors) error {
+	signing, err := store.SigningAccount()
+	if err != nil {
+		return err
+	}
+	accounts, err := store.Signer.SigningAccounts()
+	if err != nil {
+		return err
+	}
+
+	if encumbrance.HasOracle(signing.Address) {
+		return nil
+	}
+
+	for _, account := range accounts {
+		if encumbrance.HasOracle(account.Address) {
+			fe.Add(fmt.Sprintf("Service agreement encumbrance error: The signing account %s must be listed in the participating oracles", signing.Address.Hex()))
+			return nil
+		}
+	}
+	fe.Add("Service agreement encumbrance error: This node must be listed in the participating oracles")
+	return nil
+}
@@ -3,6 +3,7 @@ package services_test
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -67,6 +68,94 @@ func TestValidateJob(t *testing.T) {
 	}
 }
 
+func TestValidateJob_Labels(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	tooManyTags := make([]string, 17)
+	for i := range tooManyTags {
+		tooManyTags[i] = fmt.Sprintf("tag%d", i)
+	}
+
+	tests := []struct {
+		name        string
+		jobName     string
+		description string
+		tags        []string
+		want        error
+	}{
+		{"no labels", "", "", nil, nil},
+		{"slash in name", "ETH/USD", "", nil, models.NewJSONAPIErrorsWith("Name must start with a letter or digit and contain only letters, digits, spaces, '.', '_' or '-'")},
+		{"valid labels", "ETH USD feed_v2.1", "Median of three exchanges", []string{"eth", "price-feed", "v2.1"}, nil},
+		{"name starting with a space", " eth", "", nil, models.NewJSONAPIErrorsWith("Name must start with a letter or digit and contain only letters, digits, spaces, '.', '_' or '-'")},
+		{"long name", strings.Repeat("a", 65), "", nil, models.NewJSONAPIErrorsWith("Name cannot be longer than 64 characters")},
+		{"long description", "", strings.Repeat("a", 1001), nil, models.NewJSONAPIErrorsWith("Description cannot be longer than 1000 characters")},
+		{"uppercase tag", "", "", []string{"ETH"}, models.NewJSONAPIErrorsWith(`Tag "ETH" must be at most 32 lowercase letters, digits, '.', '_' or '-'`)},
+		{"long tag", "", "", []string{strings.Repeat("a", 33)}, models.NewJSONAPIErrorsWith(`Tag "` + strings.Repeat("a", 33) + `" must be at most 32 lowercase letters, digits, '.', '_' or '-'`)},
+		{"repeated tag", "", "", []string{"eth", "eth"}, models.NewJSONAPIErrorsWith(`Tag "eth" is repeated`)},
+		{"too many tags", "", "", tooManyTags, models.NewJSONAPIErrorsWith("Cannot have more than 16 tags")},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			j, _ := cltest.NewJobWithWebInitiator()
+			j.Name = test.jobName
+			j.Description = test.description
+			j.Tags = test.tags
+			assert.Equal(t, test.want, services.ValidateJob(j, store))
+		})
+	}
+}
+
+func TestValidateJob_DuplicateName(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	existing, _ := cltest.NewJobWithWebInitiator()
+	existing.Name = "eth-usd"
+	assert.NoError(t, store.SaveJob(&existing))
+	assert.NoError(t, services.ValidateJob(existing, store))
+
+	j, _ := cltest.NewJobWithWebInitiator()
+	j.Name = "eth-usd"
+	assert.Equal(t,
+		models.NewJSONAPIErrorsWith("Job named eth-usd already exists"),
+		services.ValidateJob(j, store))
+
+	_, err := store.ArchiveJob(existing.ID)
+	assert.NoError(t, err)
+	assert.NoError(t, services.ValidateJob(j, store))
+}
+
+func TestValidateJob_DeviationTask(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	tests := []struct {
+		name   string
+		params string
+		want   error
+	}{
+		{"threshold and heartbeat", `{"threshold":0.5,"heartbeat":"1h"}`, nil},
+		{"no params", `{}`, nil},
+		{"negative threshold", `{"threshold":-0.5}`, models.NewJSONAPIErrorsWith("Deviation threshold cannot be negative")},
+		{"negative heartbeat", `{"threshold":0.5,"heartbeat":"-1h"}`, models.NewJSONAPIErrorsWith("Deviation heartbeat cannot be negative")},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			j, _ := cltest.NewJobWithWebInitiator()
+			j.Tasks = []models.TaskSpec{cltest.NewTask("deviation", test.params)}
+			assert.Equal(t, test.want, services.ValidateJob(j, store))
+		})
+	}
+}
+
 func TestValidateAdapter(t *testing.T) {
 	t.Parallel()
 
@@ -136,6 +225,16 @@ func TestValidateInitiator(t *testing.T) {
 		{"runat w time after end at", fmt.Sprintf(`{"type":"runat","params": {"time":"%v"}}`, endAt.Add(time.Second).Unix()), true},
 		{"cron", `{"type":"cron","params": {"schedule":"* * * * * *"}}`, false},
 		{"cron w/o schedule", `{"type":"cron"}`, true},
+		{"cron w time zone", `{"type":"cron","params": {"schedule":"* * * * * *","timeZone":"America/New_York"}}`, false},
+		{"cron w invalid time zone", `{"type":"cron","params": {"schedule":"* * * * * *","timeZone":"Mars/Olympus_Mons"}}`, true},
+		{"cron w jitter and catch up", `{"type":"cron","params": {"schedule":"* * * * * *","jitter":"10s","catchUp":"once"}}`, false},
+		{"cron w negative jitter", `{"type":"cron","params": {"schedule":"* * * * * *","jitter":"-10s"}}`, true},
+		{"cron w invalid catch up", `{"type":"cron","params": {"schedule":"* * * * * *","catchUp":"sometimes"}}`, true},
+		{"interval w period", `{"type":"interval","params": {"period":"30s"}}`, false},
+		{"interval w blocks", `{"type":"interval","params": {"blocks":10}}`, false},
+		{"interval w/o period or blocks", `{"type":"interval"}`, true},
+		{"interval w period and blocks", `{"type":"interval","params": {"period":"30s","blocks":10}}`, true},
+		{"interval w sub second period", `{"type":"interval","params": {"period":"500ms"}}`, true},
 		{"non-existent initiator", `{"type":"doesntExist"}`, true},
 	}
 
@@ -203,3 +302,43 @@ func TestValidateServiceAgreement(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateServiceAgreement_OracleMembership(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	signing, err := store.KeyStore.NewAccount("password")
+	require.NoError(t, err)
+	other, err := store.KeyStore.NewAccount("password")
+	require.NoError(t, err)
+	require.NoError(t, store.KeyStore.Unlock("password"))
+
+	basic := cltest.EasyJSONFromFixture("../internal/fixtures/web/hello_world_agreement.json")
+	basic = basic.Add("endAt", time.Now().Add(72*time.Hour))
+
+	tests := []struct {
+		name    string
+		oracles []string
+		want    string
+	}{
+		{"signing account listed", []string{cltest.NewAddress().Hex(), signing.Address.Hex()}, ""},
+		{"other account listed", []string{other.Address.Hex()}, "The signing account " + signing.Address.Hex() + " must be listed"},
+		{"no account listed", []string{cltest.NewAddress().Hex()}, "This node must be listed"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sa, err := cltest.ServiceAgreementFromString(basic.Add("oracles", test.oracles).String())
+			require.NoError(t, err)
+
+			err = services.ValidateServiceAgreement(sa, store)
+			if test.want == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	null "gopkg.in/guregu/null.v3"
+)
+
+const (
+	// webhookPollInterval is how often pending runs and due retries are checked.
+	webhookPollInterval = 10 * time.Second
+	// webhookRetryBackoff is the delay before the first retry of a failed
+	// delivery, doubled for every subsequent attempt up to
+	// webhookMaxRetryBackoff.
+	webhookRetryBackoff    = 15 * time.Second
+	webhookMaxRetryBackoff = 1 * time.Hour
+	webhookTimeout         = 10 * time.Second
+)
+
+// WebhookNotifier queues notifications for configured webhooks as job runs
+// change state, and delivers them in the background, retrying failures.
+type WebhookNotifier interface {
+	store.JobRunNotifier
+	SleeperTask
+	NotifyAccount(payload models.WebhookAccountPayload)
+}
+
+type webhookNotifier struct {
+	store     *store.Store
+	deliverer SleeperTask
+	done      chan struct{}
+	mutex     sync.Mutex
+	started   bool
+}
+
+// NewWebhookNotifier returns a WebhookNotifier that stores deliveries in the
+// passed store.
+func NewWebhookNotifier(store *store.Store) WebhookNotifier {
+	return &webhookNotifier{
+		store: store,
+		deliverer: NewSleeperTask(&webhookDeliverer{
+			store:  store,
+			client: &http.Client{Timeout: webhookTimeout},
+		}),
+	}
+}
+
+// Start begins delivering queued notifications and watching for runs that
+// have been pending for too long.
+func (wn *webhookNotifier) Start() error {
+	wn.mutex.Lock()
+	defer wn.mutex.Unlock()
+	if wn.started {
+		return errors.New("WebhookNotifier already started")
+	}
+	wn.started = true
+	wn.done = make(chan struct{})
+	go wn.pollLoop(wn.done)
+	wn.deliverer.WakeUp()
+	return wn.deliverer.Start()
+}
+
+// Stop halts delivery of notifications. Queued deliveries are resumed on
+// the next Start. Stopping a notifier that is not started does nothing.
+func (wn *webhookNotifier) Stop() error {
+	wn.mutex.Lock()
+	defer wn.mutex.Unlock()
+	if !wn.started {
+		return nil
+	}
+	wn.started = false
+	close(wn.done)
+	return wn.deliverer.Stop()
+}
+
+// WakeUp asks the notifier to deliver any notifications that are due.
+func (wn *webhookNotifier) WakeUp() {
+	wn.deliverer.WakeUp()
+}
+
+// Notify queues a delivery for every webhook subscribed to the event
+// corresponding to the run's status. Runs that skipped their remaining tasks
+// did not complete the job, and are not notified.
+func (wn *webhookNotifier) Notify(run models.JobRun) {
+	event, ok := models.WebhookEventForStatus(run.Status)
+	if !ok || run.Result.Skipped {
+		return
+	}
+
+	wn.enqueue(event, run)
+	wn.deliverer.WakeUp()
+}
+
+// NotifyAccount queues a delivery of the account event for every webhook
+// subscribed to it.
+func (wn *webhookNotifier) NotifyAccount(payload models.WebhookAccountPayload) {
+	webhooks, err := wn.store.WebhooksFor(payload.Event)
+	if err != nil {
+		logger.Errorw("Webhooks: unable to load webhooks", "error", err)
+		return
+	}
+
+	for _, webhook := range webhooks {
+		delivery, err := models.NewWebhookAccountDelivery(webhook, payload)
+		if err != nil {
+			logger.Errorw("Webhooks: unable to build delivery", "webhook", webhook.ID, "error", err)
+			continue
+		}
+		delivery.CreatedAt = wn.store.Clock.Now()
+		delivery.NextAttemptAt = delivery.CreatedAt
+		if err := wn.store.SaveWebhookDelivery(&delivery); err != nil {
+			logger.Errorw("Webhooks: unable to save delivery", "webhook", webhook.ID, "error", err)
+		}
+	}
+	wn.deliverer.WakeUp()
+}
+
+func (wn *webhookNotifier) pollLoop(done chan struct{}) {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			wn.notifyPendingRuns()
+			wn.deliverer.WakeUp()
+		case <-done:
+			return
+		}
+	}
+}
+
+func (wn *webhookNotifier) notifyPendingRuns() {
+	runs, err := wn.store.JobRunsWithStatus(
+		models.RunStatusPendingConfirmations,
+		models.RunStatusPendingConnection,
+		models.RunStatusPendingBridge,
+		models.RunStatusPendingSleep,
+		models.RunStatusPendingFunds,
+	)
+	if err != nil {
+		logger.Errorw("Webhooks: unable to query pending runs", "error", err)
+		return
+	}
+
+	cutoff := wn.store.Clock.Now().Add(-wn.store.Config.WebhookPendingThreshold())
+	for _, run := range runs {
+		if run.UpdatedAt.Before(cutoff) {
+			wn.enqueue(models.WebhookEventRunPending, run)
+		}
+	}
+}
+
+func (wn *webhookNotifier) enqueue(event models.WebhookEvent, run models.JobRun) {
+	webhooks, err := wn.store.WebhooksFor(event)
+	if err != nil {
+		logger.Errorw("Webhooks: unable to load webhooks", "error", err)
+		return
+	}
+
+	for _, webhook := range webhooks {
+		exists, err := wn.store.WebhookDeliveryExists(webhook.ID, run.ID, event)
+		if err != nil {
+			logger.Errorw("Webhooks: unable to check for existing delivery", "webhook", webhook.ID, "error", err)
+			continue
+		} else if exists {
+			continue
+		}
+
+		delivery, err := models.NewWebhookDelivery(webhook, event, run)
+		if err != nil {
+			logger.Errorw("Webhooks: unable to build delivery", "webhook", webhook.ID, "error", err)
+			continue
+		}
+		delivery.CreatedAt = wn.store.Clock.Now()
+		delivery.NextAttemptAt = delivery.CreatedAt
+		if err := wn.store.SaveWebhookDelivery(&delivery); err != nil {
+			logger.Errorw("Webhooks: unable to save delivery", "webhook", webhook.ID, "error", err)
+		}
+	}
+}
+
+type webhookDeliverer struct {
+	store  *store.Store
+	client *http.Client
+}
+
+func (wd *webhookDeliverer) Work() {
+	deliveries, err := wd.store.PendingWebhookDeliveries(wd.store.Clock.Now())
+	if err != nil {
+		logger.Errorw("Webhooks: unable to query pending deliveries", "error", err)
+		return
+	}
+
+	for _, delivery := range deliveries {
+		wd.deliver(delivery)
+	}
+}
+
+func (wd *webhookDeliverer) deliver(delivery models.WebhookDelivery) {
+	webhook, err := wd.store.FindWebhook(delivery.WebhookID)
+	if err == orm.ErrorNotFound {
+		delivery.Status = models.WebhookDeliveryStatusFailed
+		delivery.Error = null.StringFrom("webhook no longer exists")
+	} else if err != nil {
+		logger.Errorw("Webhooks: unable to load webhook", "webhook", delivery.WebhookID, "error", err)
+		return
+	} else {
+		wd.attempt(webhook, &delivery)
+	}
+
+	if err := wd.store.SaveWebhookDelivery(&delivery); err != nil {
+		logger.Errorw("Webhooks: unable to save delivery", "delivery", delivery.ID, "error", err)
+	}
+}
+
+func (wd *webhookDeliverer) attempt(webhook models.Webhook, delivery *models.WebhookDelivery) {
+	delivery.Attempts++
+	now := wd.store.Clock.Now()
+
+	code, err := wd.post(webhook, []byte(delivery.Body))
+	delivery.ResponseCode = code
+	if err == nil {
+		delivery.Status = models.WebhookDeliveryStatusDelivered
+		delivery.Error = null.String{}
+		delivery.DeliveredAt = null.TimeFrom(now)
+		return
+	}
+
+	logger.Debugw("Webhooks: delivery attempt failed",
+		"webhook", webhook.ID,
+		"delivery", delivery.ID,
+		"attempts", delivery.Attempts,
+		"error", err,
+	)
+	delivery.Error = null.StringFrom(err.Error())
+	if delivery.Attempts >= wd.store.Config.WebhookMaxAttempts() {
+		delivery.Status = models.WebhookDeliveryStatusFailed
+		return
+	}
+	delivery.NextAttemptAt = now.Add(webhookBackoff(delivery.Attempts))
+}
+
+// webhookBackoff returns the delay before retrying a delivery that failed
+// the passed number of attempts.
+func webhookBackoff(attempts uint64) time.Duration {
+	backoff := webhookRetryBackoff
+	for i := uint64(1); i < attempts && backoff < webhookMaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > webhookMaxRetryBackoff {
+		return webhookMaxRetryBackoff
+	}
+	return backoff
+}
+
+func (wd *webhookDeliverer) post(webhook models.Webhook, body []byte) (int, error) {
+	req, err := http.NewRequest("POST", webhook.URL.String(), bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(models.WebhookSignatureHeader, webhook.Sign(body))
+
+	resp, err := wd.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("%s responded with %s", webhook.URL.String(), resp.Status)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,178 @@
+package services_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifier_Notify_DeliversSignedPayload(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		received <- r
+		bodies <- b
+	}))
+	defer server.Close()
+
+	webhook, err := models.NewWebhookFromRequest(models.WebhookRequest{
+		URL:    cltest.WebURL(server.URL),
+		Events: []models.WebhookEvent{models.WebhookEventRunCompleted},
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.SaveWebhook(&webhook))
+
+	notifier := services.NewWebhookNotifier(store)
+	require.NoError(t, notifier.Start())
+	defer notifier.Stop()
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	errored := job.NewRun(initiator)
+	errored.Status = models.RunStatusErrored
+	notifier.Notify(errored)
+	skipped := job.NewRun(initiator)
+	skipped.Status = models.RunStatusCompleted
+	skipped.Result = skipped.Result.MarkSkipped()
+	notifier.Notify(skipped)
+
+	run := job.NewRun(initiator)
+	run.Status = models.RunStatusCompleted
+	notifier.Notify(run)
+	notifier.Notify(run)
+
+	var req *http.Request
+	select {
+	case req = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not called")
+	}
+	body := <-bodies
+	assert.Equal(t, webhook.Sign(body), req.Header.Get(models.WebhookSignatureHeader))
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() models.WebhookDeliveryStatus {
+		deliveries, _, err := store.WebhookDeliveriesFor(webhook.ID, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1, "expected only subscribed, unique events to be queued")
+		return deliveries[0].Status
+	}).Should(gomega.Equal(models.WebhookDeliveryStatusDelivered))
+}
+
+func TestWebhookNotifier_Notify_MarksFailedAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.Set("WEBHOOK_MAX_ATTEMPTS", 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(500)
+	}))
+	defer server.Close()
+
+	webhook, err := models.NewWebhookFromRequest(models.WebhookRequest{URL: cltest.WebURL(server.URL)})
+	require.NoError(t, err)
+	require.NoError(t, store.SaveWebhook(&webhook))
+
+	notifier := services.NewWebhookNotifier(store)
+	require.NoError(t, notifier.Start())
+	defer notifier.Stop()
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	run := job.NewRun(initiator)
+	run.Status = models.RunStatusErrored
+	notifier.Notify(run)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() models.WebhookDeliveryStatus {
+		deliveries, _, err := store.WebhookDeliveriesFor(webhook.ID, 0, 10)
+		require.NoError(t, err)
+		if len(deliveries) == 0 {
+			return ""
+		}
+		return deliveries[0].Status
+	}).Should(gomega.Equal(models.WebhookDeliveryStatusFailed))
+
+	deliveries, _, err := store.WebhookDeliveriesFor(webhook.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), deliveries[0].Attempts)
+	assert.Equal(t, 500, deliveries[0].ResponseCode)
+	assert.True(t, deliveries[0].Error.Valid)
+}
+
+func TestWebhookNotifier_NotifyPendingRuns(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	clock := cltest.UseSettableClock(store)
+
+	webhook, err := models.NewWebhookFromRequest(models.WebhookRequest{
+		URL:    cltest.WebURL("http://localhost:1/hook"),
+		Events: []models.WebhookEvent{models.WebhookEventRunPending},
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.SaveWebhook(&webhook))
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	run := job.NewRun(initiator)
+	run.Status = models.RunStatusPendingBridge
+	require.NoError(t, store.SaveJobRun(&run))
+
+	notifier := services.NewWebhookNotifier(store)
+
+	services.ExportedNotifyPendingRuns(notifier)
+	count, err := store.ORM.DB.Count(&models.WebhookDelivery{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count, "expected no notification before the threshold has passed")
+
+	clock.SetTime(time.Now().Add(store.Config.WebhookPendingThreshold() + time.Minute))
+	services.ExportedNotifyPendingRuns(notifier)
+	services.ExportedNotifyPendingRuns(notifier)
+
+	deliveries, _, err := store.WebhookDeliveriesFor(webhook.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, models.WebhookEventRunPending, deliveries[0].Event)
+	assert.Equal(t, run.ID, deliveries[0].RunID)
+}
+
+func TestWebhookNotifier_Stop_WithoutStart(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	wn := services.NewWebhookNotifier(store)
+	assert.NoError(t, wn.Stop())
+
+	require.NoError(t, wn.Start())
+	assert.Error(t, wn.Start())
+	assert.NoError(t, wn.Stop())
+	assert.NoError(t, wn.Stop())
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 15*time.Second, services.ExportedWebhookBackoff(1))
+	assert.Equal(t, 30*time.Second, services.ExportedWebhookBackoff(2))
+	assert.Equal(t, 4*time.Minute, services.ExportedWebhookBackoff(5))
+	assert.Equal(t, time.Hour, services.ExportedWebhookBackoff(9))
+	assert.Equal(t, time.Hour, services.ExportedWebhookBackoff(100))
+}
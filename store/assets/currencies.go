@@ -69,6 +69,15 @@ func (l *Link) Add(x, y *Link) *Link {
 	return (*Link)(il.Add(ix, iy))
 }
 
+// Sub defers to big.Int Sub
+func (l *Link) Sub(x, y *Link) *Link {
+	il := (*big.Int)(l)
+	ix := (*big.Int)(x)
+	iy := (*big.Int)(y)
+
+	return (*Link)(il.Sub(ix, iy))
+}
+
 // Text defers to big.Int Text
 func (l *Link) Text(base int) string {
 	return (*big.Int)(l).Text(base)
@@ -120,6 +129,11 @@ func (e *Eth) SetInt64(w int64) *Eth {
 	return (*Eth)(e.ToInt().SetInt64(w))
 }
 
+// Add returns the sum of x and y as ETH
+func (e *Eth) Add(x, y *Eth) *Eth {
+	return (*Eth)(e.ToInt().Add(x.ToInt(), y.ToInt()))
+}
+
 // SetString delegates to *big.Int.SetString
 func (e *Eth) SetString(s string, base int) (*Eth, bool) {
 	w, ok := e.ToInt().SetString(s, base)
@@ -90,6 +90,23 @@ func TestAssets_Eth_IsZero(t *testing.T) {
 	assert.False(t, oneWei.IsZero())
 }
 
+func TestAssets_Link_Sub(t *testing.T) {
+	t.Parallel()
+
+	diff := assets.NewLink(0)
+	diff.Sub(assets.NewLink(7), assets.NewLink(3))
+	assert.Equal(t, assets.NewLink(4), diff)
+}
+
+func TestAssets_Eth_Add(t *testing.T) {
+	t.Parallel()
+
+	sum := assets.NewEth(0)
+	sum.Add(sum, assets.NewEth(3))
+	sum.Add(sum, assets.NewEth(4))
+	assert.Equal(t, assets.NewEth(7), sum)
+}
+
 func TestAssets_Eth_MarshalJson(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/store/assets"
+)
+
+// BalanceTracker records the last known ETH balance of the node's accounts
+// so that transactions can be held while any of them is running low.
+type BalanceTracker struct {
+	balances map[common.Address]*assets.Eth
+	low      map[common.Address]bool
+	mutex    sync.RWMutex
+}
+
+// NewBalanceTracker returns a BalanceTracker without any balances.
+func NewBalanceTracker() *BalanceTracker {
+	return &BalanceTracker{
+		balances: map[common.Address]*assets.Eth{},
+		low:      map[common.Address]bool{},
+	}
+}
+
+// Update records the account's balance, returning whether it is below the
+// threshold and whether that differs from the previous update.
+func (bt *BalanceTracker) Update(address common.Address, balance, threshold *assets.Eth) (low bool, changed bool) {
+	bt.mutex.Lock()
+	defer bt.mutex.Unlock()
+
+	low = balance.Cmp(threshold) < 0
+	changed = low != bt.low[address]
+	bt.balances[address] = balance
+	bt.low[address] = low
+	return low, changed
+}
+
+// Balance returns the last known balance of the account, or nil if it has
+// not been checked.
+func (bt *BalanceTracker) Balance(address common.Address) *assets.Eth {
+	bt.mutex.RLock()
+	defer bt.mutex.RUnlock()
+	return bt.balances[address]
+}
+
+// Funded returns true if no account was below the threshold when last
+// checked.
+func (bt *BalanceTracker) Funded() bool {
+	bt.mutex.RLock()
+	defer bt.mutex.RUnlock()
+	for _, low := range bt.low {
+		if low {
+			return false
+		}
+	}
+	return true
+}
+
+// Retain stops tracking every account but the ones passed, for example after
+// an account was removed from the keystore.
+func (bt *BalanceTracker) Retain(addresses []common.Address) {
+	bt.mutex.Lock()
+	defer bt.mutex.Unlock()
+
+	keep := map[common.Address]bool{}
+	for _, address := range addresses {
+		keep[address] = true
+	}
+	for address := range bt.low {
+		if !keep[address] {
+			delete(bt.balances, address)
+			delete(bt.low, address)
+		}
+	}
+}
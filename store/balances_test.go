@@ -0,0 +1,57 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalanceTracker_Update(t *testing.T) {
+	t.Parallel()
+
+	bt := store.NewBalanceTracker()
+	first := cltest.NewAddress()
+	second := cltest.NewAddress()
+	threshold := assets.NewEth(10)
+	assert.True(t, bt.Funded())
+	assert.Nil(t, bt.Balance(first))
+
+	low, changed := bt.Update(first, assets.NewEth(10), threshold)
+	assert.False(t, low)
+	assert.False(t, changed)
+
+	low, changed = bt.Update(second, assets.NewEth(9), threshold)
+	assert.True(t, low)
+	assert.True(t, changed)
+	assert.False(t, bt.Funded())
+	assert.Equal(t, assets.NewEth(9), bt.Balance(second))
+
+	low, changed = bt.Update(second, assets.NewEth(8), threshold)
+	assert.True(t, low)
+	assert.False(t, changed, "should only report the transition to low once")
+
+	low, changed = bt.Update(second, assets.NewEth(20), threshold)
+	assert.False(t, low)
+	assert.True(t, changed)
+	assert.True(t, bt.Funded())
+}
+
+func TestBalanceTracker_Retain(t *testing.T) {
+	t.Parallel()
+
+	bt := store.NewBalanceTracker()
+	kept := cltest.NewAddress()
+	removed := cltest.NewAddress()
+	bt.Update(kept, assets.NewEth(20), assets.NewEth(10))
+	bt.Update(removed, assets.NewEth(1), assets.NewEth(10))
+	assert.False(t, bt.Funded())
+
+	bt.Retain([]common.Address{kept})
+	assert.True(t, bt.Funded())
+	assert.Nil(t, bt.Balance(removed))
+	assert.Equal(t, assets.NewEth(20), bt.Balance(kept))
+}
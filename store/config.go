@@ -20,6 +20,7 @@ import (
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/spf13/afero"
 	"github.com/spf13/viper"
@@ -40,6 +41,10 @@ type Config struct {
 // ConfigSchema records the schema of configuration at the type level
 type ConfigSchema struct {
 	AllowOrigins             string         `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
+	BackupCompress           bool           `env:"BACKUP_COMPRESS" default:"true"`
+	BackupDir                string         `env:"BACKUP_DIR"`
+	BackupInterval           time.Duration  `env:"BACKUP_INTERVAL" default:"0s"`
+	BackupRetention          uint64         `env:"BACKUP_RETENTION" default:"7"`
 	BridgeResponseURL        url.URL        `env:"BRIDGE_RESPONSE_URL"`
 	ChainID                  uint64         `env:"ETH_CHAIN_ID" default:"0"`
 	ClientNodeURL            string         `env:"CLIENT_NODE_URL" default:"http://localhost:6688"`
@@ -47,12 +52,18 @@ type ConfigSchema struct {
 	Dev                      bool           `env:"CHAINLINK_DEV" default:"false"`
 	MaximumServiceDuration   time.Duration  `env:"MAXIMUM_SERVICE_DURATION" default:"8760h" `
 	MinimumServiceDuration   time.Duration  `env:"MINIMUM_SERVICE_DURATION" default:"0s" `
+	EthBalanceThreshold      big.Int        `env:"ETH_BALANCE_THRESHOLD" default:"100000000000000000"`
 	EthGasBumpThreshold      uint64         `env:"ETH_GAS_BUMP_THRESHOLD" default:"12" `
 	EthGasBumpWei            big.Int        `env:"ETH_GAS_BUMP_WEI" default:"5000000000"`
 	EthGasPriceDefault       big.Int        `env:"ETH_GAS_PRICE_DEFAULT" default:"20000000000"`
 	EthereumURL              string         `env:"ETH_URL" default:"ws://localhost:8546"`
+	EthTxHoldOnLowBalance    bool           `env:"ETH_TX_HOLD_ON_LOW_BALANCE" default:"false"`
 	JSONConsole              bool           `env:"JSON_CONSOLE" default:"false"`
 	LinkContractAddress      string         `env:"LINK_CONTRACT_ADDRESS" default:"0x514910771AF9Ca656af840dff83E8264EcF986CA"`
+	LinkSweepAddress         common.Address `env:"LINK_SWEEP_ADDRESS"`
+	LinkSweepBlocks          uint64         `env:"LINK_SWEEP_BLOCKS" default:"100"`
+	LinkSweepReserve         assets.Link    `env:"LINK_SWEEP_RESERVE" default:"0"`
+	LinkSweepThreshold       assets.Link    `env:"LINK_SWEEP_THRESHOLD" default:"100000000000000000000"`
 	LogLevel                 LogLevel       `env:"LOG_LEVEL" default:"info"`
 	LogToDisk                bool           `env:"LOG_TO_DISK" default:"true"`
 	MinIncomingConfirmations uint64         `env:"MIN_INCOMING_CONFIRMATIONS" default:"0"`
@@ -62,12 +73,20 @@ type ConfigSchema struct {
 	OracleContractAddress    common.Address `env:"ORACLE_CONTRACT_ADDRESS"`
 	Port                     uint16         `env:"CHAINLINK_PORT" default:"6688"`
 	ReaperExpiration         time.Duration  `env:"REAPER_EXPIRATION" default:"240h"`
+	ReaperInterval           time.Duration  `env:"REAPER_INTERVAL" default:"1h"`
 	RootDir                  string         `env:"ROOT" default:"~/.chainlink"`
+	RunRetentionMaxAge       time.Duration  `env:"RUN_RETENTION_MAX_AGE" default:"0s"`
+	RunRetentionMaxCount     uint64         `env:"RUN_RETENTION_MAX_COUNT" default:"0"`
+	RunRetentionStatuses     string         `env:"RUN_RETENTION_STATUSES" default:"completed,errored"`
 	SessionTimeout           time.Duration  `env:"SESSION_TIMEOUT" default:"15m"`
+	SignerURL                string         `env:"SIGNER_URL"`
+	SigningAccount           string         `env:"SIGNING_ACCOUNT"`
 	TLSCertPath              string         `env:"TLS_CERT_PATH" `
 	TLSHost                  string         `env:"CHAINLINK_TLS_HOST" `
 	TLSKeyPath               string         `env:"TLS_KEY_PATH" `
 	TLSPort                  uint16         `env:"CHAINLINK_TLS_PORT" default:"6689"`
+	WebhookMaxAttempts       uint64         `env:"WEBHOOK_MAX_ATTEMPTS" default:"5"`
+	WebhookPendingThreshold  time.Duration  `env:"WEBHOOK_PENDING_THRESHOLD" default:"30m"`
 }
 
 // NewConfig returns the config with the environment variables set to their
@@ -124,6 +143,33 @@ func (c Config) AllowOrigins() string {
 	return c.viper.GetString(c.envVarName("AllowOrigins"))
 }
 
+// BackupCompress is whether scheduled backups are gzip compressed.
+func (c Config) BackupCompress() bool {
+	return c.viper.GetBool(c.envVarName("BackupCompress"))
+}
+
+// BackupDir is where scheduled backups are written, the backups directory
+// under RootDir unless configured.
+func (c Config) BackupDir() string {
+	dir := c.viper.GetString(c.envVarName("BackupDir"))
+	if dir == "" {
+		return path.Join(c.RootDir(), "backups")
+	}
+	return dir
+}
+
+// BackupInterval is how often a backup of the database is written to
+// BackupDir. Zero disables scheduled backups.
+func (c Config) BackupInterval() time.Duration {
+	return c.viper.GetDuration(c.envVarName("BackupInterval"))
+}
+
+// BackupRetention is the number of scheduled backups kept, the oldest being
+// removed as new ones are written. Zero keeps them all.
+func (c Config) BackupRetention() uint64 {
+	return uint64(c.viper.GetInt64(c.envVarName("BackupRetention")))
+}
+
 // BridgeResponseURL represents the URL for bridges to send a response to.
 func (c Config) BridgeResponseURL() *url.URL {
 	return c.getWithFallback("BridgeResponseURL", parseURL).(*url.URL)
@@ -167,6 +213,18 @@ func (c Config) EthGasBumpThreshold() uint64 {
 	return uint64(c.viper.GetInt64(c.envVarName("EthGasBumpThreshold")))
 }
 
+// EthBalanceThreshold is the ETH balance below which an account is reported
+// as running low on funds.
+func (c Config) EthBalanceThreshold() *assets.Eth {
+	return (*assets.Eth)(c.getWithFallback("EthBalanceThreshold", parseBigInt).(*big.Int))
+}
+
+// EthTxHoldOnLowBalance holds new transactions in a pending state while an
+// account is below the EthBalanceThreshold, instead of sending them.
+func (c Config) EthTxHoldOnLowBalance() bool {
+	return c.viper.GetBool(c.envVarName("EthTxHoldOnLowBalance"))
+}
+
 // EthGasBumpWei represents the intervals in which ETH should be increased when
 // doing gas bumping.
 func (c Config) EthGasBumpWei() *big.Int {
@@ -193,6 +251,33 @@ func (c Config) LinkContractAddress() string {
 	return c.viper.GetString(c.envVarName("LinkContractAddress"))
 }
 
+// LinkSweepAddress is the cold address LINK earned by the oracle contract is
+// swept to. Sweeping is disabled when it is not set.
+func (c Config) LinkSweepAddress() *common.Address {
+	if c.viper.GetString(c.envVarName("LinkSweepAddress")) == "" {
+		return nil
+	}
+	return c.getWithFallback("LinkSweepAddress", parseAddress).(*common.Address)
+}
+
+// LinkSweepBlocks is the number of blocks between checks of the oracle
+// contract's LINK balance.
+func (c Config) LinkSweepBlocks() uint64 {
+	return uint64(c.viper.GetInt64(c.envVarName("LinkSweepBlocks")))
+}
+
+// LinkSweepReserve is the amount of LINK left in the oracle contract after a
+// sweep.
+func (c Config) LinkSweepReserve() *assets.Link {
+	return c.getWithFallback("LinkSweepReserve", parseLink).(*assets.Link)
+}
+
+// LinkSweepThreshold is the oracle contract LINK balance that triggers a
+// sweep.
+func (c Config) LinkSweepThreshold() *assets.Link {
+	return c.getWithFallback("LinkSweepThreshold", parseLink).(*assets.Link)
+}
+
 // OracleContractAddress represents the deployed Oracle contract's address.
 func (c Config) OracleContractAddress() *common.Address {
 	if c.viper.GetString(c.envVarName("OracleContractAddress")) == "" {
@@ -252,11 +337,47 @@ func (c Config) RootDir() string {
 	return c.getWithFallback("RootDir", parseHomeDir).(string)
 }
 
+// ReaperInterval is how often the reaper runs on its own, to delete stale
+// sessions and apply the run retention policy.
+func (c Config) ReaperInterval() time.Duration {
+	return c.viper.GetDuration(c.envVarName("ReaperInterval"))
+}
+
+// RunRetentionMaxAge is how long completed and errored runs are kept after
+// their last update. Zero keeps them regardless of age.
+func (c Config) RunRetentionMaxAge() time.Duration {
+	return c.viper.GetDuration(c.envVarName("RunRetentionMaxAge"))
+}
+
+// RunRetentionMaxCount is the number of most recent runs kept for each job
+// and status. Zero keeps them regardless of count.
+func (c Config) RunRetentionMaxCount() uint64 {
+	return uint64(c.viper.GetInt64(c.envVarName("RunRetentionMaxCount")))
+}
+
+// RunRetentionStatuses are the statuses of the runs that the retention
+// policy deletes. Only completed and errored runs can be deleted.
+func (c Config) RunRetentionStatuses() []models.RunStatus {
+	return c.getWithFallback("RunRetentionStatuses", parseRunStatuses).([]models.RunStatus)
+}
+
 // SessionTimeout is the maximum duration that a user session can persist without any activity.
 func (c Config) SessionTimeout() time.Duration {
 	return c.viper.GetDuration(c.envVarName("SessionTimeout"))
 }
 
+// SignerURL is the JSON-RPC endpoint of a remote signer holding the node's
+// keys. When empty, keys are read from the keystore in the root directory.
+func (c Config) SignerURL() string {
+	return c.viper.GetString(c.envVarName("SignerURL"))
+}
+
+// SigningAccount is the address of the account used to sign service
+// agreements. When empty, the first account is used.
+func (c Config) SigningAccount() string {
+	return c.viper.GetString(c.envVarName("SigningAccount"))
+}
+
 // TLSCertPath represents the file system location of the TLS certificate
 // Chainlink should use for HTTPS.
 func (c Config) TLSCertPath() string {
@@ -280,6 +401,23 @@ func (c Config) TLSPort() uint16 {
 	return c.getWithFallback("TLSPort", parsePort).(uint16)
 }
 
+// WebhookMaxAttempts is the number of times a webhook delivery is attempted
+// before it is marked as failed.
+func (c Config) WebhookMaxAttempts() uint64 {
+	return uint64(c.viper.GetInt64(c.envVarName("WebhookMaxAttempts")))
+}
+
+// WebhookPendingThreshold is how long a run can remain pending before
+// subscribed webhooks are notified.
+func (c Config) WebhookPendingThreshold() time.Duration {
+	return c.viper.GetDuration(c.envVarName("WebhookPendingThreshold"))
+}
+
+// DatabasePath returns the path of the node's Bolt database file.
+func (c Config) DatabasePath() string {
+	return path.Join(c.RootDir(), "db.bolt")
+}
+
 // KeysDir returns the path of the keys directory (used for keystore files).
 func (c Config) KeysDir() string {
 	return path.Join(c.RootDir(), "keys")
@@ -448,6 +586,10 @@ func parseBigInt(str string) (interface{}, error) {
 	return i, nil
 }
 
+func parseRunStatuses(str string) (interface{}, error) {
+	return models.ParseRunRetentionStatuses(str)
+}
+
 func parseHomeDir(str string) (interface{}, error) {
 	return homedir.Expand(str)
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -165,3 +166,49 @@ func TestStore_urlParser(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_runStatusesParser(t *testing.T) {
+	val, err := parseRunStatuses("completed, errored")
+	assert.NoError(t, err)
+	assert.Equal(t, []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored}, val)
+
+	val, err = parseRunStatuses("")
+	assert.NoError(t, err)
+	assert.Equal(t, []models.RunStatus{}, val)
+
+	val, err = parseRunStatuses("completed,in_progress")
+	assert.Error(t, err)
+}
+
+func TestConfig_RunRetention(t *testing.T) {
+	t.Parallel()
+	config := NewConfig()
+	assert.Equal(t, time.Duration(0), config.RunRetentionMaxAge())
+	assert.Equal(t, uint64(0), config.RunRetentionMaxCount())
+	assert.Equal(t, []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored}, config.RunRetentionStatuses())
+	assert.Equal(t, time.Hour, config.ReaperInterval())
+
+	config.Set("RUN_RETENTION_MAX_AGE", "720h")
+	config.Set("RUN_RETENTION_MAX_COUNT", "100")
+	config.Set("RUN_RETENTION_STATUSES", "errored")
+	assert.Equal(t, 720*time.Hour, config.RunRetentionMaxAge())
+	assert.Equal(t, uint64(100), config.RunRetentionMaxCount())
+	assert.Equal(t, []models.RunStatus{models.RunStatusErrored}, config.RunRetentionStatuses())
+
+	config.Set("RUN_RETENTION_STATUSES", "pending_bridge")
+	assert.Equal(t, []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored}, config.RunRetentionStatuses())
+}
+
+func TestConfig_Backup(t *testing.T) {
+	t.Parallel()
+	config := NewConfig()
+	config.Set("ROOT", "/tmp/chainlink_test/TestConfig_Backup")
+	assert.Equal(t, "/tmp/chainlink_test/TestConfig_Backup/backups", config.BackupDir())
+	assert.Equal(t, "/tmp/chainlink_test/TestConfig_Backup/db.bolt", config.DatabasePath())
+	assert.Equal(t, time.Duration(0), config.BackupInterval())
+	assert.Equal(t, uint64(7), config.BackupRetention())
+	assert.True(t, config.BackupCompress())
+
+	config.Set("BACKUP_DIR", "/var/backups/chainlink")
+	assert.Equal(t, "/var/backups/chainlink", config.BackupDir())
+}
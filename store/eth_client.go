@@ -154,11 +154,12 @@ func (eth *EthClient) SubscribeToNewHeads(
 	return sub, err
 }
 
-// TxReceipt holds the block number and the transaction hash of a signed
-// transaction that has been written to the blockchain.
+// TxReceipt holds the block number, the transaction hash and the gas used by
+// a signed transaction that has been written to the blockchain.
 type TxReceipt struct {
 	BlockNumber *models.Int `json:"blockNumber"`
 	Hash        common.Hash `json:"transactionHash"`
+	GasUsed     *models.Int `json:"gasUsed,omitempty"`
 }
 
 var emptyHash = common.Hash{}
@@ -0,0 +1,199 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// EventType identifies the kind of change an Event describes.
+type EventType string
+
+const (
+	// EventTypeJobRun is published when a JobRun is saved while processing.
+	EventTypeJobRun = EventType("jobRun")
+	// EventTypeHead is published when a new block header is received.
+	EventTypeHead = EventType("head")
+	// EventTypeTxAttempt is published when a transaction attempt is created
+	// or confirmed.
+	EventTypeTxAttempt = EventType("txAttempt")
+)
+
+// NewEventType validates and returns an EventType.
+func NewEventType(val string) (EventType, error) {
+	et := EventType(val)
+	switch et {
+	case EventTypeJobRun, EventTypeHead, EventTypeTxAttempt:
+		return et, nil
+	default:
+		return "", fmt.Errorf("%v is not a supported event type", val)
+	}
+}
+
+// eventBufferSize is the number of events buffered for each subscriber
+// before further events are dropped.
+const eventBufferSize = 100
+
+// Event is a change of state within the node, published to any interested
+// subscribers. JobID is only set for events that relate to a job.
+type Event struct {
+	Type  EventType   `json:"type"`
+	JobID string      `json:"jobId,omitempty"`
+	Data  interface{} `json:"data"`
+}
+
+// EventFilter restricts the events delivered to a subscription. Empty fields
+// match everything.
+type EventFilter struct {
+	JobIDs []string
+	Types  []EventType
+}
+
+// NewEventFilter builds a filter from comma separated lists of job IDs and
+// event types.
+func NewEventFilter(jobIDs, types string) (EventFilter, error) {
+	filter := EventFilter{JobIDs: splitList(jobIDs)}
+	for _, t := range splitList(types) {
+		et, err := NewEventType(t)
+		if err != nil {
+			return EventFilter{}, err
+		}
+		filter.Types = append(filter.Types, et)
+	}
+	return filter, nil
+}
+
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Matches returns true if the event passes the filter. When filtering by job,
+// events that are not tied to a job, such as new heads and transaction
+// attempts, are left out.
+func (f EventFilter) Matches(e Event) bool {
+	if len(f.Types) > 0 && !containsEventType(f.Types, e.Type) {
+		return false
+	}
+	if len(f.JobIDs) > 0 && !containsString(f.JobIDs, e.JobID) {
+		return false
+	}
+	return true
+}
+
+func containsEventType(types []EventType, et EventType) bool {
+	for _, t := range types {
+		if t == et {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// EventBroadcaster fans out published events to every matching subscription.
+// Publishing never blocks: events are dropped for subscribers that have
+// fallen behind.
+type EventBroadcaster struct {
+	subscriptions map[*EventSubscription]struct{}
+	mutex         sync.RWMutex
+}
+
+// NewEventBroadcaster returns an EventBroadcaster without any subscribers.
+func NewEventBroadcaster() *EventBroadcaster {
+	return &EventBroadcaster{
+		subscriptions: map[*EventSubscription]struct{}{},
+	}
+}
+
+// Publish sends the event to all subscriptions whose filter matches it.
+// Publishing to a nil EventBroadcaster does nothing.
+func (eb *EventBroadcaster) Publish(e Event) {
+	if eb == nil {
+		return
+	}
+
+	eb.mutex.RLock()
+	defer eb.mutex.RUnlock()
+	for sub := range eb.subscriptions {
+		if !sub.filter.Matches(e) {
+			continue
+		}
+		select {
+		case sub.events <- e:
+		default:
+		}
+	}
+}
+
+// PublishJobRun publishes the state of a JobRun.
+func (eb *EventBroadcaster) PublishJobRun(run models.JobRun) {
+	eb.Publish(Event{Type: EventTypeJobRun, JobID: run.JobID, Data: run})
+}
+
+// PublishHead publishes a newly received block header.
+func (eb *EventBroadcaster) PublishHead(head models.BlockHeader) {
+	eb.Publish(Event{Type: EventTypeHead, Data: head})
+}
+
+// PublishTxAttempt publishes the state of a transaction attempt.
+func (eb *EventBroadcaster) PublishTxAttempt(txat models.TxAttempt) {
+	eb.Publish(Event{Type: EventTypeTxAttempt, Data: txat})
+}
+
+// Subscribe returns a subscription receiving the events that match the filter.
+func (eb *EventBroadcaster) Subscribe(filter EventFilter) *EventSubscription {
+	sub := &EventSubscription{
+		events:      make(chan Event, eventBufferSize),
+		filter:      filter,
+		broadcaster: eb,
+	}
+
+	eb.mutex.Lock()
+	defer eb.mutex.Unlock()
+	eb.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+func (eb *EventBroadcaster) unsubscribe(sub *EventSubscription) {
+	eb.mutex.Lock()
+	defer eb.mutex.Unlock()
+	if _, ok := eb.subscriptions[sub]; ok {
+		delete(eb.subscriptions, sub)
+		close(sub.events)
+	}
+}
+
+// EventSubscription receives the events published to an EventBroadcaster
+// that match its filter.
+type EventSubscription struct {
+	events      chan Event
+	filter      EventFilter
+	broadcaster *EventBroadcaster
+}
+
+// Events returns the channel on which matching events are delivered. It is
+// closed on Unsubscribe.
+func (s *EventSubscription) Events() <-chan Event {
+	return s.events
+}
+
+// Unsubscribe stops delivery of events and closes the Events channel.
+func (s *EventSubscription) Unsubscribe() {
+	s.broadcaster.unsubscribe(s)
+}
@@ -0,0 +1,94 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventFilter(t *testing.T) {
+	t.Parallel()
+
+	filter, err := store.NewEventFilter("a, b", "jobRun,head")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, filter.JobIDs)
+	assert.Equal(t, []store.EventType{store.EventTypeJobRun, store.EventTypeHead}, filter.Types)
+
+	filter, err = store.NewEventFilter("", "")
+	require.NoError(t, err)
+	assert.Empty(t, filter.JobIDs)
+	assert.Empty(t, filter.Types)
+
+	_, err = store.NewEventFilter("", "bogus")
+	assert.Error(t, err)
+}
+
+func TestEventFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	run := store.Event{Type: store.EventTypeJobRun, JobID: "a"}
+	otherRun := store.Event{Type: store.EventTypeJobRun, JobID: "b"}
+	head := store.Event{Type: store.EventTypeHead}
+	txAttempt := store.Event{Type: store.EventTypeTxAttempt}
+
+	tests := []struct {
+		name   string
+		filter store.EventFilter
+		event  store.Event
+		want   bool
+	}{
+		{"empty filter", store.EventFilter{}, run, true},
+		{"matching job", store.EventFilter{JobIDs: []string{"a"}}, run, true},
+		{"other job", store.EventFilter{JobIDs: []string{"a"}}, otherRun, false},
+		{"job filter excludes heads", store.EventFilter{JobIDs: []string{"a"}}, head, false},
+		{"job filter excludes tx attempts", store.EventFilter{JobIDs: []string{"a"}}, txAttempt, false},
+		{"tx attempts without job filter", store.EventFilter{}, txAttempt, true},
+		{"matching type", store.EventFilter{Types: []store.EventType{store.EventTypeHead}}, head, true},
+		{"other type", store.EventFilter{Types: []store.EventType{store.EventTypeHead}}, run, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.filter.Matches(test.event))
+		})
+	}
+}
+
+func TestEventBroadcaster_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	eb := store.NewEventBroadcaster()
+	all := eb.Subscribe(store.EventFilter{})
+	heads := eb.Subscribe(store.EventFilter{Types: []store.EventType{store.EventTypeHead}})
+
+	eb.PublishJobRun(models.JobRun{ID: "run", JobID: "job"})
+	eb.PublishHead(models.BlockHeader{})
+
+	event := <-all.Events()
+	assert.Equal(t, store.EventTypeJobRun, event.Type)
+	assert.Equal(t, "job", event.JobID)
+	event = <-all.Events()
+	assert.Equal(t, store.EventTypeHead, event.Type)
+
+	event = <-heads.Events()
+	assert.Equal(t, store.EventTypeHead, event.Type)
+
+	heads.Unsubscribe()
+	_, ok := <-heads.Events()
+	assert.False(t, ok, "expected events channel to be closed")
+	heads.Unsubscribe()
+
+	all.Unsubscribe()
+}
+
+func TestEventBroadcaster_PublishNil(t *testing.T) {
+	t.Parallel()
+
+	var eb *store.EventBroadcaster
+	assert.NotPanics(t, func() {
+		eb.PublishHead(models.BlockHeader{})
+	})
+}
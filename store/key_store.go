@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store/models"
@@ -66,6 +67,48 @@ func (ks *KeyStore) NewAccount(passphrase string) (accounts.Account, error) {
 	return account, nil
 }
 
+// GetAccountByAddress returns the account in the keystore matching the
+// address.
+func (ks *KeyStore) GetAccountByAddress(address common.Address) (accounts.Account, error) {
+	return ks.Find(accounts.Account{Address: address})
+}
+
+// Import adds a key from an encrypted keyfile, re-encrypting and unlocking it
+// with the passphrase used by the rest of the keystore.
+func (ks *KeyStore) Import(keyJSON []byte, keyPassphrase, passphrase string) (accounts.Account, error) {
+	if err := ks.Unlock(passphrase); err != nil {
+		return accounts.Account{}, err
+	}
+	account, err := ks.KeyStore.Import(keyJSON, keyPassphrase, passphrase)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+	return account, ks.KeyStore.Unlock(account, passphrase)
+}
+
+// Export returns the encrypted keyfile of the account with the address,
+// re-encrypted with newPassphrase.
+func (ks *KeyStore) Export(address common.Address, passphrase, newPassphrase string) ([]byte, error) {
+	account, err := ks.GetAccountByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return ks.KeyStore.Export(account, passphrase, newPassphrase)
+}
+
+// Delete removes the account with the address from the keystore. The last
+// remaining account cannot be deleted since the node needs one to operate.
+func (ks *KeyStore) Delete(address common.Address, passphrase string) (accounts.Account, error) {
+	account, err := ks.GetAccountByAddress(address)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+	if len(ks.Accounts()) == 1 {
+		return accounts.Account{}, errors.New("cannot delete the only account in the keystore")
+	}
+	return account, ks.KeyStore.Delete(account, passphrase)
+}
+
 // SignTx uses the unlocked account to sign the given transaction.
 func (ks *KeyStore) SignTx(account accounts.Account, tx *types.Transaction, chainID uint64) (*types.Transaction, error) {
 	return ks.KeyStore.SignTx(
@@ -75,12 +118,17 @@ func (ks *KeyStore) SignTx(account accounts.Account, tx *types.Transaction, chai
 	)
 }
 
-// Sign creates an HMAC from some input data using the account's private key
+// Sign creates an HMAC from some input data using the first account's private key
 func (ks *KeyStore) Sign(input []byte) (models.Signature, error) {
 	account, err := ks.GetFirstAccount()
 	if err != nil {
 		return models.Signature{}, err
 	}
+	return ks.SignWith(account, input)
+}
+
+// SignWith creates an HMAC from some input data using the account's private key
+func (ks *KeyStore) SignWith(account accounts.Account, input []byte) (models.Signature, error) {
 	hash, err := utils.Keccak256(input)
 	if err != nil {
 		return models.Signature{}, err
@@ -6,6 +6,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const correctPassphrase = "p@ssword"
@@ -91,3 +92,38 @@ func TestKeyStore_SignAccountLocked(t *testing.T) {
 	_, err = store.KeyStore.Sign([]byte("abc123"))
 	assert.Error(t, err)
 }
+
+func TestKeyStore_ExportDeleteImport(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	account, err := store.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+	_, err = store.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+
+	_, err = store.KeyStore.Export(account.Address, "wrong phrase", "kwyjibo")
+	assert.Error(t, err)
+	keyJSON, err := store.KeyStore.Export(account.Address, correctPassphrase, "kwyjibo")
+	require.NoError(t, err)
+
+	_, err = store.KeyStore.Delete(account.Address, "wrong phrase")
+	assert.Error(t, err)
+	deleted, err := store.KeyStore.Delete(account.Address, correctPassphrase)
+	require.NoError(t, err)
+	assert.Equal(t, account.Address, deleted.Address)
+	require.Len(t, store.KeyStore.Accounts(), 1)
+
+	remaining := store.KeyStore.Accounts()[0]
+	_, err = store.KeyStore.Delete(remaining.Address, correctPassphrase)
+	assert.Error(t, err, "should not delete the only account")
+
+	_, err = store.KeyStore.Import(keyJSON, "wrong phrase", correctPassphrase)
+	assert.Error(t, err)
+	imported, err := store.KeyStore.Import(keyJSON, "kwyjibo", correctPassphrase)
+	require.NoError(t, err)
+	assert.Equal(t, account.Address, imported.Address)
+	assert.Len(t, store.KeyStore.Accounts(), 2)
+	assert.NoError(t, store.KeyStore.Unlock(correctPassphrase))
+}
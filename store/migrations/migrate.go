@@ -4,11 +4,15 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/asdine/storm"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store/migrations/migration0"
 	"github.com/smartcontractkit/chainlink/store/migrations/migration1536696950"
 	"github.com/smartcontractkit/chainlink/store/migrations/migration1536764911"
 	"github.com/smartcontractkit/chainlink/store/migrations/migration1537223654"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539637200"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539810000"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539900000"
 	"github.com/smartcontractkit/chainlink/store/orm"
 )
 
@@ -17,6 +21,9 @@ func init() {
 	registerMigration(migration1536696950.Migration{})
 	registerMigration(migration1536764911.Migration{})
 	registerMigration(migration1537223654.Migration{})
+	registerMigration(migration1539637200.Migration{})
+	registerMigration(migration1539810000.Migration{})
+	registerMigration(migration1539900000.Migration{})
 }
 
 type migration interface {
@@ -67,6 +74,50 @@ func Migrate(orm *orm.ORM) error {
 	return nil
 }
 
+// MigrationStatus compares the migrations run on a database with those
+// available to this version of the node.
+type MigrationStatus struct {
+	// Run are the migrations that have been run on the database.
+	Run []string
+	// Pending are the available migrations that have not been run.
+	Pending []string
+	// Unknown are the migrations that have been run but are not available,
+	// meaning the database was last used by a newer version of the node.
+	Unknown []string
+}
+
+// Status returns the MigrationStatus of the database, without running any
+// migrations.
+func Status(orm *orm.ORM) (MigrationStatus, error) {
+	var migrationTimestamps []MigrationTimestamp
+	err := orm.All(&migrationTimestamps)
+	if err != nil && err != storm.ErrNotFound {
+		return MigrationStatus{}, err
+	}
+
+	status := MigrationStatus{Run: []string{}, Pending: []string{}, Unknown: []string{}}
+	run := make(map[string]bool)
+	for _, mt := range migrationTimestamps {
+		run[mt.Timestamp] = true
+		status.Run = append(status.Run, mt.Timestamp)
+	}
+	sort.Strings(status.Run)
+
+	available := make(map[string]bool)
+	for _, ts := range availableMigrationTimestamps() {
+		available[ts] = true
+		if !run[ts] {
+			status.Pending = append(status.Pending, ts)
+		}
+	}
+	for _, ts := range status.Run {
+		if !available[ts] {
+			status.Unknown = append(status.Unknown, ts)
+		}
+	}
+	return status, nil
+}
+
 var migrationMutex sync.RWMutex
 var availableMigrations = make(map[string]migration)
 
@@ -52,3 +52,22 @@ func TestMigrate_RunNewMigrations(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, tm.Timestamp(), migrationTimestamps[1].Timestamp, "Migration should have been registered as run")
 }
+
+func TestStatus(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	status, err := migrations.Status(store.ORM)
+	require.NoError(t, err)
+	assert.Equal(t, migrations.ExportedAvailableMigrationTimestamps(), status.Run)
+	assert.Empty(t, status.Pending)
+	assert.Empty(t, status.Unknown)
+
+	require.NoError(t, store.DeleteStruct(&migrations.MigrationTimestamp{Timestamp: "0"}))
+	require.NoError(t, store.Save(&migrations.MigrationTimestamp{Timestamp: "9999999999"}))
+
+	status, err = migrations.Status(store.ORM)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0"}, status.Pending)
+	assert.Equal(t, []string{"9999999999"}, status.Unknown)
+}
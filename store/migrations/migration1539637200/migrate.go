@@ -0,0 +1,64 @@
+package migration1539637200
+
+import (
+	"github.com/asdine/storm"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration0"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539637200/old"
+	"github.com/smartcontractkit/chainlink/store/orm"
+)
+
+type Migration struct{}
+
+func (m Migration) Timestamp() string {
+	return "1539637200"
+}
+
+func (m Migration) Migrate(orm *orm.ORM) error {
+	var oldTasks []old.BulkDeleteRunTask
+	if err := orm.All(&oldTasks); err != nil && err != storm.ErrNotFound {
+		return err
+	}
+
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, ot := range oldTasks {
+		newTask := convertBulkDeleteRunTask(ot)
+		if err := tx.Save(&newTask); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// unknownError replaces errors that were saved before their message was
+// kept. The error interface serialized to an empty object, losing it.
+const unknownError = "bulk delete failed, error message was not recorded"
+
+func convertBulkDeleteRunTask(ot old.BulkDeleteRunTask) BulkDeleteRunTask {
+	var message string
+	switch v := ot.Error.(type) {
+	case nil:
+	case string:
+		message = v
+	default:
+		message = unknownError
+	}
+	return BulkDeleteRunTask{
+		ID:     ot.ID,
+		Query:  ot.Query,
+		Status: ot.Status,
+		Error:  message,
+	}
+}
+
+type BulkDeleteRunTask struct {
+	ID     migration0.Unchanged `json:"id" storm:"id,unique"`
+	Query  migration0.Unchanged `json:"query"`
+	Status migration0.Unchanged `json:"status"`
+	Error  string               `json:"error,omitempty"`
+}
@@ -0,0 +1,47 @@
+package migration1539637200_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539637200"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539637200/old"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1539637200_convertsBulkDeleteRunTaskErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		want    string
+	}{
+		{"1539637200_bulk_delete_task_with_error.json", "bulk delete failed, error message was not recorded"},
+		{"1539637200_bulk_delete_task_without_error.json", ""},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.fixture, func(t *testing.T) {
+			store, cleanup := cltest.NewStore()
+			defer cleanup()
+
+			input := cltest.LoadJSON("../../../internal/fixtures/migrations/" + test.fixture)
+			var t1 old.BulkDeleteRunTask
+			require.NoError(t, json.Unmarshal(input, &t1))
+			require.NoError(t, store.ORM.DB.Save(&t1))
+
+			migration := migration1539637200.Migration{}
+			require.NoError(t, migration.Migrate(store.ORM))
+
+			var t2 migration1539637200.BulkDeleteRunTask
+			require.NoError(t, store.One("ID", t1.ID, &t2))
+
+			assert.Equal(t, t1.Status, t2.Status)
+			assert.Equal(t, t1.Query, t2.Query)
+			assert.Equal(t, test.want, t2.Error)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package old
+
+import "github.com/smartcontractkit/chainlink/store/migrations/migration0"
+
+type BulkDeleteRunTask struct {
+	ID     migration0.Unchanged `json:"id" storm:"id,unique"`
+	Query  migration0.Unchanged `json:"query"`
+	Status migration0.Unchanged `json:"status"`
+	Error  interface{}          `json:"error"`
+}
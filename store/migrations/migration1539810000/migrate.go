@@ -0,0 +1,103 @@
+package migration1539810000
+
+import (
+	"fmt"
+
+	"github.com/asdine/storm"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration0"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539810000/old"
+	"github.com/smartcontractkit/chainlink/store/orm"
+)
+
+type Migration struct{}
+
+func (m Migration) Timestamp() string {
+	return "1539810000"
+}
+
+// Migrate saves every existing job as its first version.
+func (m Migration) Migrate(orm *orm.ORM) error {
+	var oldJobs []old.JobSpec
+	if err := orm.All(&oldJobs); err != nil && err != storm.ErrNotFound {
+		return err
+	}
+
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, oj := range oldJobs {
+		newJob := convertJobSpec(oj)
+		if err := tx.Save(&newJob); err != nil {
+			return err
+		}
+		version := newJobSpecVersion(newJob)
+		if err := tx.Save(&version); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func convertJobSpec(oj old.JobSpec) JobSpec {
+	return JobSpec{
+		ID:          oj.ID,
+		CreatedAt:   oj.CreatedAt,
+		ArchivedAt:  oj.ArchivedAt,
+		Version:     1,
+		Name:        oj.Name,
+		Description: oj.Description,
+		Tags:        oj.Tags,
+		Initiators:  oj.Initiators,
+		Tasks:       oj.Tasks,
+		StartAt:     oj.StartAt,
+		EndAt:       oj.EndAt,
+	}
+}
+
+func newJobSpecVersion(j JobSpec) JobSpecVersion {
+	return JobSpecVersion{
+		ID:          fmt.Sprintf("%v-%d", j.ID, j.Version),
+		JobID:       fmt.Sprint(j.ID),
+		Version:     j.Version,
+		CreatedAt:   j.CreatedAt,
+		Name:        j.Name,
+		Description: j.Description,
+		Tags:        j.Tags,
+		Initiators:  j.Initiators,
+		Tasks:       j.Tasks,
+		StartAt:     j.StartAt,
+		EndAt:       j.EndAt,
+	}
+}
+
+type JobSpec struct {
+	ID          migration0.Unchanged `json:"id" storm:"id,unique"`
+	CreatedAt   migration0.Unchanged `json:"createdAt" storm:"index"`
+	ArchivedAt  migration0.Unchanged `json:"archivedAt"`
+	Version     uint32               `json:"version"`
+	Name        migration0.Unchanged `json:"name,omitempty" storm:"index"`
+	Description migration0.Unchanged `json:"description,omitempty"`
+	Tags        migration0.Unchanged `json:"tags,omitempty"`
+	Initiators  migration0.Unchanged `json:"initiators"`
+	Tasks       migration0.Unchanged `json:"tasks" storm:"inline"`
+	StartAt     migration0.Unchanged `json:"startAt" storm:"index"`
+	EndAt       migration0.Unchanged `json:"endAt" storm:"index"`
+}
+
+type JobSpecVersion struct {
+	ID          string               `json:"id" storm:"id,unique"`
+	JobID       string               `json:"jobId" storm:"index"`
+	Version     uint32               `json:"version"`
+	CreatedAt   migration0.Unchanged `json:"createdAt"`
+	Name        migration0.Unchanged `json:"name,omitempty"`
+	Description migration0.Unchanged `json:"description,omitempty"`
+	Tags        migration0.Unchanged `json:"tags,omitempty"`
+	Initiators  migration0.Unchanged `json:"initiators"`
+	Tasks       migration0.Unchanged `json:"tasks"`
+	StartAt     migration0.Unchanged `json:"startAt"`
+	EndAt       migration0.Unchanged `json:"endAt"`
+}
@@ -0,0 +1,40 @@
+package migration1539810000_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539810000"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539810000/old"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1539810000_savesJobsAsFirstVersion(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	input := cltest.LoadJSON("../../../internal/fixtures/migrations/1539810000_job_without_version.json")
+	var j1 old.JobSpec
+	require.NoError(t, json.Unmarshal(input, &j1))
+	require.NoError(t, store.ORM.DB.Save(&j1))
+
+	migration := migration1539810000.Migration{}
+	require.NoError(t, migration.Migrate(store.ORM))
+
+	var j2 migration1539810000.JobSpec
+	require.NoError(t, store.One("ID", j1.ID, &j2))
+	assert.Equal(t, uint32(1), j2.Version)
+	assert.Equal(t, j1.Name, j2.Name)
+	assert.Equal(t, j1.Initiators, j2.Initiators)
+
+	var v migration1539810000.JobSpecVersion
+	require.NoError(t, store.One("JobID", "somethingGenerated", &v))
+	assert.Equal(t, "somethingGenerated-1", v.ID)
+	assert.Equal(t, uint32(1), v.Version)
+	assert.Equal(t, j1.CreatedAt, v.CreatedAt)
+	assert.Equal(t, j1.Tags, v.Tags)
+	assert.Equal(t, j1.Tasks, v.Tasks)
+}
@@ -0,0 +1,16 @@
+package old
+
+import "github.com/smartcontractkit/chainlink/store/migrations/migration0"
+
+type JobSpec struct {
+	ID          migration0.Unchanged `json:"id" storm:"id,unique"`
+	CreatedAt   migration0.Unchanged `json:"createdAt" storm:"index"`
+	ArchivedAt  migration0.Unchanged `json:"archivedAt"`
+	Name        migration0.Unchanged `json:"name,omitempty" storm:"index"`
+	Description migration0.Unchanged `json:"description,omitempty"`
+	Tags        migration0.Unchanged `json:"tags,omitempty"`
+	Initiators  migration0.Unchanged `json:"initiators"`
+	Tasks       migration0.Unchanged `json:"tasks" storm:"inline"`
+	StartAt     migration0.Unchanged `json:"startAt" storm:"index"`
+	EndAt       migration0.Unchanged `json:"endAt" storm:"index"`
+}
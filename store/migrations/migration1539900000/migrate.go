@@ -0,0 +1,80 @@
+package migration1539900000
+
+import (
+	"github.com/asdine/storm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration0"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539900000/old"
+	"github.com/smartcontractkit/chainlink/store/orm"
+)
+
+type Migration struct{}
+
+func (m Migration) Timestamp() string {
+	return "1539900000"
+}
+
+// Migrate saves every existing run again, so that runs saved before
+// Requester was indexed can be filtered by it.
+func (m Migration) Migrate(orm *orm.ORM) error {
+	var oldRuns []old.JobRun
+	if err := orm.All(&oldRuns); err != nil && err != storm.ErrNotFound {
+		return err
+	}
+
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, oldRun := range oldRuns {
+		newRun := convertJobRun(oldRun)
+		if err := tx.Save(&newRun); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func convertJobRun(oldRun old.JobRun) JobRun {
+	var requester *common.Address
+	if hex, ok := oldRun.Requester.(string); ok && hex != "" {
+		address := common.HexToAddress(hex)
+		requester = &address
+	}
+	return JobRun{
+		ID:             oldRun.ID,
+		JobID:          oldRun.JobID,
+		Result:         oldRun.Result,
+		Status:         oldRun.Status,
+		TaskRuns:       oldRun.TaskRuns,
+		CreatedAt:      oldRun.CreatedAt,
+		CompletedAt:    oldRun.CompletedAt,
+		UpdatedAt:      oldRun.UpdatedAt,
+		Initiator:      oldRun.Initiator,
+		CreationHeight: oldRun.CreationHeight,
+		ObservedHeight: oldRun.ObservedHeight,
+		Overrides:      oldRun.Overrides,
+		Requester:      requester,
+		JobVersion:     oldRun.JobVersion,
+	}
+}
+
+type JobRun struct {
+	ID             migration0.Unchanged `json:"id" storm:"id,unique"`
+	JobID          migration0.Unchanged `json:"jobId" storm:"index"`
+	Result         migration0.Unchanged `json:"result" storm:"inline"`
+	Status         migration0.Unchanged `json:"status" storm:"index"`
+	TaskRuns       migration0.Unchanged `json:"taskRuns" storm:"inline"`
+	CreatedAt      migration0.Unchanged `json:"createdAt" storm:"index"`
+	CompletedAt    migration0.Unchanged `json:"completedAt"`
+	UpdatedAt      migration0.Unchanged `json:"updatedAt"`
+	Initiator      migration0.Unchanged `json:"initiator"`
+	CreationHeight migration0.Unchanged `json:"creationHeight"`
+	ObservedHeight migration0.Unchanged `json:"observedHeight"`
+	Overrides      migration0.Unchanged `json:"overrides"`
+	Requester      *common.Address      `json:"requester,omitempty" storm:"index"`
+	JobVersion     migration0.Unchanged `json:"jobVersion"`
+}
@@ -0,0 +1,39 @@
+package migration1539900000_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539900000"
+	"github.com/smartcontractkit/chainlink/store/migrations/migration1539900000/old"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1539900000_indexesRunRequesters(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	requester := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	requested := old.JobRun{ID: "requested", JobID: "job", Status: "completed", Requester: requester.Hex()}
+	require.NoError(t, store.ORM.DB.Save(&requested))
+	unrequested := old.JobRun{ID: "unrequested", JobID: "job", Status: "completed"}
+	require.NoError(t, store.ORM.DB.Save(&unrequested))
+
+	var runs []models.JobRun
+	assert.Error(t, store.Find("Requester", &requester, &runs))
+
+	migration := migration1539900000.Migration{}
+	require.NoError(t, migration.Migrate(store.ORM))
+
+	require.NoError(t, store.Find("Requester", &requester, &runs))
+	require.Len(t, runs, 1)
+	assert.Equal(t, "requested", runs[0].ID)
+
+	var run migration1539900000.JobRun
+	require.NoError(t, store.One("ID", "unrequested", &run))
+	assert.Nil(t, run.Requester)
+}
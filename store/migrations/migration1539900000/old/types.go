@@ -0,0 +1,20 @@
+package old
+
+import "github.com/smartcontractkit/chainlink/store/migrations/migration0"
+
+type JobRun struct {
+	ID             migration0.Unchanged `json:"id" storm:"id,unique"`
+	JobID          migration0.Unchanged `json:"jobId" storm:"index"`
+	Result         migration0.Unchanged `json:"result" storm:"inline"`
+	Status         migration0.Unchanged `json:"status" storm:"index"`
+	TaskRuns       migration0.Unchanged `json:"taskRuns" storm:"inline"`
+	CreatedAt      migration0.Unchanged `json:"createdAt" storm:"index"`
+	CompletedAt    migration0.Unchanged `json:"completedAt"`
+	UpdatedAt      migration0.Unchanged `json:"updatedAt"`
+	Initiator      migration0.Unchanged `json:"initiator"`
+	CreationHeight migration0.Unchanged `json:"creationHeight"`
+	ObservedHeight migration0.Unchanged `json:"observedHeight"`
+	Overrides      migration0.Unchanged `json:"overrides"`
+	Requester      migration0.Unchanged `json:"requester,omitempty"`
+	JobVersion     migration0.Unchanged `json:"jobVersion"`
+}
@@ -1,7 +1,9 @@
 package models
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/utils"
@@ -17,20 +19,109 @@ const (
 	BulkTaskStatusErrored = BulkTaskStatus("errored")
 	// BulkTaskStatusCompleted means a bulk task finished.
 	BulkTaskStatusCompleted = BulkTaskStatus("completed")
+	// BulkTaskStatusCancelled means a bulk task was stopped before it finished.
+	BulkTaskStatusCancelled = BulkTaskStatus("cancelled")
 )
 
-// BulkDeleteRunRequest describes the query for deletion of runs
+// BulkDeleteRunRequest describes the query for deletion of runs. Runs must
+// have one of the statuses, and match each of the other filters that is set.
+// A zero UpdatedBefore or CompletedBefore sets no bound, but a request must
+// set at least one of them.
 type BulkDeleteRunRequest struct {
-	Status        []RunStatus `json:"status"`
-	UpdatedBefore time.Time   `json:"updatedBefore"`
+	Status          []RunStatus `json:"status"`
+	UpdatedBefore   time.Time   `json:"updatedBefore"`
+	CompletedBefore time.Time   `json:"completedBefore"`
+	JobSpecIDs      []string    `json:"jobSpecIds"`
+	InitiatorTypes  []string    `json:"initiatorTypes"`
 }
 
-// BulkDeleteRunTask represents a task that is working to delete runs with a query
+// Matches returns true if the run would be deleted by the query.
+func (r BulkDeleteRunRequest) Matches(run JobRun) bool {
+	if !containsStatus(r.Status, run.Status) {
+		return false
+	} else if !r.UpdatedBefore.IsZero() && !run.UpdatedAt.Before(r.UpdatedBefore) {
+		return false
+	} else if !r.CompletedBefore.IsZero() && (!run.CompletedAt.Valid || !run.CompletedAt.Time.Before(r.CompletedBefore)) {
+		return false
+	} else if len(r.JobSpecIDs) > 0 && !containsString(r.JobSpecIDs, run.JobID) {
+		return false
+	} else if len(r.InitiatorTypes) > 0 && !containsString(r.InitiatorTypes, run.Initiator.Type) {
+		return false
+	}
+	return true
+}
+
+func containsStatus(statuses []RunStatus, status RunStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// RunRetentionPolicy describes which completed and errored runs the reaper
+// deletes: those last updated more than MaxAge ago, and those beyond the
+// MaxCount most recent runs of their job and status. A zero MaxAge or
+// MaxCount disables that rule.
+type RunRetentionPolicy struct {
+	MaxAge   time.Duration `json:"maxAge"`
+	MaxCount uint64        `json:"maxCount"`
+	Statuses []RunStatus   `json:"statuses"`
+}
+
+// Enabled returns true if the policy would delete any runs.
+func (p RunRetentionPolicy) Enabled() bool {
+	return len(p.Statuses) > 0 && (p.MaxAge > 0 || p.MaxCount > 0)
+}
+
+// ParseRunRetentionStatuses parses a comma separated list of the statuses
+// of runs that can be deleted, completed and errored.
+func ParseRunRetentionStatuses(str string) ([]RunStatus, error) {
+	statuses := []RunStatus{}
+	for _, name := range strings.Split(str, ",") {
+		status := RunStatus(strings.TrimSpace(name))
+		if status == "" {
+			continue
+		} else if status != RunStatusCompleted && status != RunStatusErrored {
+			return statuses, fmt.Errorf("Unable to parse '%s', only completed and errored runs can be deleted", str)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// AgeQuery returns the bulk delete query for the runs older than MaxAge at
+// the given time.
+func (p RunRetentionPolicy) AgeQuery(now time.Time) BulkDeleteRunRequest {
+	return BulkDeleteRunRequest{
+		Status:        p.Statuses,
+		UpdatedBefore: now.Add(-p.MaxAge),
+	}
+}
+
+// BulkDeleteRunTask represents a task that is working to delete runs with a
+// query. Scanned counts the runs with one of the query's statuses that have
+// been checked against the rest of the query so far, and Deleted those
+// removed. Runs are checked in ID order, and LastRunID is the last one
+// checked, so a task that is interrupted resumes after it.
 type BulkDeleteRunTask struct {
-	ID     string               `json:"id" storm:"id,unique"`
-	Query  BulkDeleteRunRequest `json:"query"`
-	Status BulkTaskStatus       `json:"status"`
-	Error  error                `json:"error"`
+	ID        string               `json:"id" storm:"id,unique"`
+	Query     BulkDeleteRunRequest `json:"query"`
+	Status    BulkTaskStatus       `json:"status"`
+	Error     string               `json:"error,omitempty"`
+	Scanned   uint64               `json:"scanned"`
+	Deleted   uint64               `json:"deleted"`
+	LastRunID string               `json:"lastRunId,omitempty"`
 }
 
 // NewBulkDeleteRunTask returns a task from a request to make a task
@@ -40,6 +131,9 @@ func NewBulkDeleteRunTask(request BulkDeleteRunRequest) (*BulkDeleteRunTask, err
 			return nil, fmt.Errorf("cannot delete Runs with status %s", status)
 		}
 	}
+	if request.UpdatedBefore.IsZero() && request.CompletedBefore.IsZero() {
+		return nil, errors.New("must pass updatedBefore or completedBefore to bound the runs deleted")
+	}
 
 	return &BulkDeleteRunTask{
 		ID:    utils.NewBytes32ID(),
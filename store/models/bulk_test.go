@@ -2,21 +2,83 @@ package models
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	null "gopkg.in/guregu/null.v3"
 )
 
 func TestNewBulkDeleteRunTask(t *testing.T) {
-	task, err := NewBulkDeleteRunTask(BulkDeleteRunRequest{})
+	cutoff := time.Date(2018, 11, 15, 0, 0, 0, 0, time.UTC)
+	completed := []RunStatus{RunStatusCompleted}
+
+	task, err := NewBulkDeleteRunTask(BulkDeleteRunRequest{Status: completed, UpdatedBefore: cutoff})
 	assert.NoError(t, err)
 	assert.NotEmpty(t, task.ID)
 
-	task, err = NewBulkDeleteRunTask(BulkDeleteRunRequest{Status: []RunStatus{RunStatusCompleted}})
+	_, err = NewBulkDeleteRunTask(BulkDeleteRunRequest{Status: completed, CompletedBefore: cutoff})
 	assert.NoError(t, err)
 
-	task, err = NewBulkDeleteRunTask(BulkDeleteRunRequest{Status: []RunStatus{""}})
+	_, err = NewBulkDeleteRunTask(BulkDeleteRunRequest{Status: completed})
+	assert.Error(t, err, "expected a request without a time bound to be rejected")
+
+	_, err = NewBulkDeleteRunTask(BulkDeleteRunRequest{Status: []RunStatus{""}, UpdatedBefore: cutoff})
 	assert.Error(t, err)
 
-	task, err = NewBulkDeleteRunTask(BulkDeleteRunRequest{Status: []RunStatus{RunStatusInProgress}})
+	_, err = NewBulkDeleteRunTask(BulkDeleteRunRequest{Status: []RunStatus{RunStatusInProgress}, UpdatedBefore: cutoff})
 	assert.Error(t, err)
 }
+
+func TestBulkDeleteRunRequest_Matches(t *testing.T) {
+	cutoff := time.Date(2018, 11, 15, 0, 0, 0, 0, time.UTC)
+	before := cutoff.Add(-time.Hour)
+	after := cutoff.Add(time.Hour)
+	run := JobRun{
+		JobID:       "job",
+		Status:      RunStatusCompleted,
+		UpdatedAt:   before,
+		CompletedAt: null.TimeFrom(before),
+		Initiator:   Initiator{Type: InitiatorWeb},
+	}
+	completed := []RunStatus{RunStatusCompleted}
+
+	tests := []struct {
+		name    string
+		request BulkDeleteRunRequest
+		run     JobRun
+		want    bool
+	}{
+		{"status only", BulkDeleteRunRequest{Status: completed}, run, true},
+		{"no status", BulkDeleteRunRequest{}, run, false},
+		{"other status", BulkDeleteRunRequest{Status: []RunStatus{RunStatusErrored}}, run, false},
+		{"updated before", BulkDeleteRunRequest{Status: completed, UpdatedBefore: cutoff}, run, true},
+		{"updated after", BulkDeleteRunRequest{Status: completed, UpdatedBefore: before}, run, false},
+		{"completed before", BulkDeleteRunRequest{Status: completed, CompletedBefore: after}, run, true},
+		{"completed after", BulkDeleteRunRequest{Status: completed, CompletedBefore: before}, run, false},
+		{"never completed", BulkDeleteRunRequest{Status: completed, CompletedBefore: after}, JobRun{Status: RunStatusCompleted}, false},
+		{"job spec ID", BulkDeleteRunRequest{Status: completed, JobSpecIDs: []string{"other", "job"}}, run, true},
+		{"other job spec ID", BulkDeleteRunRequest{Status: completed, JobSpecIDs: []string{"other"}}, run, false},
+		{"initiator type", BulkDeleteRunRequest{Status: completed, InitiatorTypes: []string{InitiatorWeb}}, run, true},
+		{"other initiator type", BulkDeleteRunRequest{Status: completed, InitiatorTypes: []string{InitiatorCron}}, run, false},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.request.Matches(test.run))
+		})
+	}
+}
+
+func TestRunRetentionPolicy(t *testing.T) {
+	statuses := []RunStatus{RunStatusCompleted}
+	assert.False(t, RunRetentionPolicy{Statuses: statuses}.Enabled())
+	assert.False(t, RunRetentionPolicy{MaxAge: time.Hour}.Enabled())
+	assert.True(t, RunRetentionPolicy{MaxAge: time.Hour, Statuses: statuses}.Enabled())
+	assert.True(t, RunRetentionPolicy{MaxCount: 10, Statuses: statuses}.Enabled())
+
+	now := time.Now()
+	query := RunRetentionPolicy{MaxAge: time.Hour, Statuses: statuses}.AgeQuery(now)
+	assert.Equal(t, statuses, query.Status)
+	assert.Equal(t, now.Add(-time.Hour), query.UpdatedBefore)
+}
@@ -35,12 +35,33 @@ const (
 	RunStatusPendingBridge = RunStatus("pending_bridge")
 	// RunStatusPendingSleep is used for when a run is waiting on a sleep function to finish.
 	RunStatusPendingSleep = RunStatus("pending_sleep")
+	// RunStatusPendingFunds is used for when a run is holding a transaction
+	// until the node's accounts have enough ETH to pay for gas.
+	RunStatusPendingFunds = RunStatus("pending_funds")
 	// RunStatusErrored is used for when a run has errored and will not complete.
 	RunStatusErrored = RunStatus("errored")
 	// RunStatusCompleted is used for when a run has successfully completed execution.
 	RunStatusCompleted = RunStatus("completed")
 )
 
+// NewRunStatus validates and returns the RunStatus named by val.
+func NewRunStatus(val string) (RunStatus, error) {
+	status := RunStatus(val)
+	switch status {
+	case RunStatusInProgress,
+		RunStatusPendingConfirmations,
+		RunStatusPendingConnection,
+		RunStatusPendingBridge,
+		RunStatusPendingSleep,
+		RunStatusPendingFunds,
+		RunStatusErrored,
+		RunStatusCompleted:
+		return status, nil
+	default:
+		return "", fmt.Errorf("%v is not a valid run status", val)
+	}
+}
+
 // Unstarted returns true if the status is the initial state.
 func (s RunStatus) Unstarted() bool {
 	return s == RunStatusUnstarted
@@ -66,6 +87,11 @@ func (s RunStatus) PendingSleep() bool {
 	return s == RunStatusPendingSleep
 }
 
+// PendingFunds returns true if the status is pending_funds.
+func (s RunStatus) PendingFunds() bool {
+	return s == RunStatusPendingFunds
+}
+
 // Completed returns true if the status is RunStatusCompleted.
 func (s RunStatus) Completed() bool {
 	return s == RunStatusCompleted
@@ -78,7 +104,7 @@ func (s RunStatus) Errored() bool {
 
 // Pending returns true if the status is pending external or confirmations.
 func (s RunStatus) Pending() bool {
-	return s.PendingBridge() || s.PendingConfirmations() || s.PendingSleep() || s.PendingConnection()
+	return s.PendingBridge() || s.PendingConfirmations() || s.PendingSleep() || s.PendingConnection() || s.PendingFunds()
 }
 
 // Finished returns true if the status is final and can't be changed.
@@ -292,6 +318,64 @@ func (c Cron) String() string {
 	return string(c)
 }
 
+// Schedule parses the Cron spec into a schedule that computes its fire times
+// in the given location.
+func (c Cron) Schedule(loc *time.Location) (cron.Schedule, error) {
+	s, err := cron.Parse(string(c))
+	if err != nil {
+		return nil, fmt.Errorf("Cron: %v", err)
+	}
+	return locatedSchedule{schedule: s, loc: loc}, nil
+}
+
+type locatedSchedule struct {
+	schedule cron.Schedule
+	loc      *time.Location
+}
+
+// Next returns the next fire time after t, as seen from the location of the
+// schedule.
+func (ls locatedSchedule) Next(t time.Time) time.Time {
+	return ls.schedule.Next(t.In(ls.loc))
+}
+
+// Duration is a time.Duration that is represented in JSON as a string
+// such as "1m30s".
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string, such as "30s", into the Duration.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("Duration: %v", err)
+	}
+	if s == "" {
+		*d = 0
+		return nil
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("Duration: %v", err)
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// MarshalJSON returns the Duration as a duration string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// Duration returns the Duration as a time.Duration.
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+// String returns the duration string of the Duration.
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
 // WithdrawalRequest request to withdraw LINK.
 type WithdrawalRequest struct {
 	DestinationAddress common.Address `json:"address"`
@@ -311,6 +395,35 @@ type CreateKeyRequest struct {
 	NewAccountPassword string `json:"new_account_password"`
 }
 
+// ImportKeyRequest represents a request to import an encrypted ethereum
+// keyfile, which is re-encrypted with the node's current password.
+type ImportKeyRequest struct {
+	Key             json.RawMessage `json:"key"`
+	KeyPassword     string          `json:"key_password"`
+	CurrentPassword string          `json:"current_password"`
+}
+
+// ExportKeyRequest represents a request to export an ethereum key as a
+// keyfile encrypted with a new password.
+type ExportKeyRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// DeleteKeyRequest represents a request to remove an ethereum key from the
+// node. Force removes it even while transactions sent from it are still
+// unconfirmed.
+type DeleteKeyRequest struct {
+	CurrentPassword string `json:"current_password"`
+	Force           bool   `json:"force"`
+}
+
+// RotateSigningAccountRequest represents a request to sign new service
+// agreements with another account in the node's keystore.
+type RotateSigningAccountRequest struct {
+	Address common.Address `json:"address"`
+}
+
 // Int stores large integers and can deserialize a variety of inputs.
 type Int big.Int
 
@@ -260,6 +260,20 @@ func TestTime_DurationFromNow(t *testing.T) {
 	assert.True(t, 0 < duration)
 }
 
+func TestDuration_JSON(t *testing.T) {
+	t.Parallel()
+
+	var d models.Duration
+	assert.NoError(t, json.Unmarshal([]byte(`"1m30s"`), &d))
+	assert.Equal(t, 90*time.Second, d.Duration())
+
+	b, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Equal(t, `"1m30s"`, string(b))
+
+	assert.Error(t, json.Unmarshal([]byte(`"soon"`), &d))
+}
+
 func TestInt_UnmarshalText(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// DeviationSubmission records the last value a job with a "deviation" task
+// submitted, against which the deviation of newly polled values is measured.
+// While a run that passed the task is still submitting its value, that value
+// is recorded as in flight and measured against instead, so that the same
+// value is not submitted again while the first is pending confirmations.
+type DeviationSubmission struct {
+	JobID         string `json:"jobId" storm:"id,unique"`
+	JobRunID      string `json:"jobRunId"`
+	Value         string `json:"value"`
+	SubmittedAt   Time   `json:"submittedAt"`
+	InFlightRunID string `json:"inFlightRunId,omitempty"`
+	InFlightValue string `json:"inFlightValue,omitempty"`
+	InFlightAt    Time   `json:"inFlightAt"`
+}
+
+// NewDeviationSubmission returns a DeviationSubmission of the value for the
+// job run, submitted at the passed time.
+func NewDeviationSubmission(run JobRun, value string, at time.Time) DeviationSubmission {
+	return DeviationSubmission{
+		JobID:       run.JobID,
+		JobRunID:    run.ID,
+		Value:       value,
+		SubmittedAt: Time{Time: at},
+	}
+}
+
+// InFlight returns true while a run is submitting a value.
+func (ds DeviationSubmission) InFlight() bool {
+	return ds.InFlightRunID != ""
+}
+
+// Latest returns the value in flight, or else the last submitted value, and
+// when it passed the "deviation" task. It returns false when there is
+// neither.
+func (ds DeviationSubmission) Latest() (string, time.Time, bool) {
+	if ds.InFlight() {
+		return ds.InFlightValue, ds.InFlightAt.Time, true
+	}
+	return ds.Value, ds.SubmittedAt.Time, ds.JobRunID != ""
+}
+
+// StartInFlight records the value of the job run as in flight, replacing
+// any value already in flight.
+func (ds *DeviationSubmission) StartInFlight(run JobRun, value string, at time.Time) {
+	ds.JobID = run.JobID
+	ds.InFlightRunID = run.ID
+	ds.InFlightValue = value
+	ds.InFlightAt = Time{Time: at}
+}
+
+// Submitted records the value of the job run as submitted, clearing it from
+// flight.
+func (ds *DeviationSubmission) Submitted(run JobRun, value string, at time.Time) {
+	ds.JobID = run.JobID
+	ds.JobRunID = run.ID
+	ds.Value = value
+	ds.SubmittedAt = Time{Time: at}
+	ds.clearInFlight(run.ID)
+}
+
+// Abort clears the value of the job run from flight, once it has failed to
+// submit it.
+func (ds *DeviationSubmission) Abort(run JobRun) {
+	ds.clearInFlight(run.ID)
+}
+
+func (ds *DeviationSubmission) clearInFlight(runID string) {
+	if ds.InFlightRunID == runID {
+		ds.InFlightRunID = ""
+		ds.InFlightValue = ""
+		ds.InFlightAt = Time{}
+	}
+}
+
+// Deviation returns the absolute difference between value and the latest
+// value, as a percentage of the latest value. It is infinite when the latest
+// value is zero and value is not.
+func (ds DeviationSubmission) Deviation(value *big.Float) (*big.Float, error) {
+	latest, _, _ := ds.Latest()
+	last, ok := new(big.Float).SetString(latest)
+	if !ok {
+		return nil, fmt.Errorf("cannot parse submitted value into big.Float: %v", latest)
+	}
+
+	diff := new(big.Float).Sub(value, last)
+	diff.Abs(diff)
+	if last.Sign() == 0 {
+		if diff.Sign() == 0 {
+			return diff, nil
+		}
+		return new(big.Float).SetInf(false), nil
+	}
+	diff.Mul(diff, big.NewFloat(100))
+	return diff.Quo(diff, new(big.Float).Abs(last)), nil
+}
@@ -0,0 +1,75 @@
+package models_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviationSubmission_Deviation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		last    string
+		value   float64
+		want    string
+		errored bool
+	}{
+		{"increase", "200", 201, "0.5", false},
+		{"decrease", "200", 190, "5", false},
+		{"negative", "-200", -201, "0.5", false},
+		{"unchanged", "200", 200, "0", false},
+		{"unchanged zero", "0", 0, "0", false},
+		{"from zero", "0", 1, "+Inf", false},
+		{"unparseable", "abc", 1, "", true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			ds := models.DeviationSubmission{Value: test.last}
+			deviation, err := ds.Deviation(big.NewFloat(test.value))
+			if test.errored {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, deviation.Text('g', 10))
+		})
+	}
+}
+
+func TestDeviationSubmission_InFlight(t *testing.T) {
+	t.Parallel()
+
+	at := time.Unix(1546344000, 0)
+	first := models.JobRun{ID: "first", JobID: "job"}
+	second := models.JobRun{ID: "second", JobID: "job"}
+
+	ds := models.DeviationSubmission{JobID: "job"}
+	_, _, ok := ds.Latest()
+	assert.False(t, ok)
+
+	ds.StartInFlight(first, "100", at)
+	value, _, ok := ds.Latest()
+	assert.True(t, ok)
+	assert.Equal(t, "100", value)
+
+	ds.StartInFlight(second, "102", at)
+	ds.Submitted(first, "100", at)
+	assert.True(t, ds.InFlight())
+	value, _, _ = ds.Latest()
+	assert.Equal(t, "102", value)
+
+	ds.Abort(second)
+	assert.False(t, ds.InFlight())
+	value, submittedAt, ok := ds.Latest()
+	assert.True(t, ok)
+	assert.Equal(t, "100", value)
+	assert.Equal(t, at, submittedAt)
+}
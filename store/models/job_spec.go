@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/mrwonko/cron"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/utils"
 	null "gopkg.in/guregu/null.v3"
@@ -17,18 +18,27 @@ import (
 // JobSpec is the definition for all the work to be carried out by the node
 // for a given contract. It contains the Initiators, Tasks (which are the
 // individual steps to be carried out), StartAt, EndAt, and CreatedAt fields.
+// Archived jobs are kept for their runs but are no longer started.
+// Updating a job saves it as its next Version under the same ID.
 type JobSpec struct {
-	ID        string `json:"id" storm:"id,unique"`
-	CreatedAt Time   `json:"createdAt" storm:"index"`
+	ID         string    `json:"id" storm:"id,unique"`
+	CreatedAt  Time      `json:"createdAt" storm:"index"`
+	ArchivedAt null.Time `json:"archivedAt"`
+	Version    uint32    `json:"version"`
 	JobSpecRequest
 }
 
 // JobSpecRequest represents a schema for the incoming job spec request as used by the API.
+// Name, Description and Tags are optional. When present, Name identifies the
+// job across nodes.
 type JobSpecRequest struct {
-	Initiators []Initiator `json:"initiators"`
-	Tasks      []TaskSpec  `json:"tasks" storm:"inline"`
-	StartAt    null.Time   `json:"startAt" storm:"index"`
-	EndAt      null.Time   `json:"endAt" storm:"index"`
+	Name        string      `json:"name,omitempty" storm:"index"`
+	Description string      `json:"description,omitempty"`
+	Tags        []string    `json:"tags,omitempty"`
+	Initiators  []Initiator `json:"initiators"`
+	Tasks       []TaskSpec  `json:"tasks" storm:"inline"`
+	StartAt     null.Time   `json:"startAt" storm:"index"`
+	EndAt       null.Time   `json:"endAt" storm:"index"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -60,6 +70,9 @@ func NewJob() JobSpec {
 // JobSpecRequest
 func NewJobFromRequest(jsr JobSpecRequest) JobSpec {
 	jobSpec := NewJob()
+	jobSpec.Name = jsr.Name
+	jobSpec.Description = jsr.Description
+	jobSpec.Tags = jsr.Tags
 	jobSpec.Initiators = jsr.Initiators
 	jobSpec.Tasks = jsr.Tasks
 	jobSpec.EndAt = jsr.EndAt
@@ -82,15 +95,63 @@ func (j JobSpec) NewRun(i Initiator) JobRun {
 
 	now := time.Now()
 	return JobRun{
-		ID:        jrid,
-		JobID:     j.ID,
-		CreatedAt: now,
-		UpdatedAt: now,
-		TaskRuns:  taskRuns,
-		Initiator: i,
-		Status:    RunStatusUnstarted,
-		Result:    RunResult{JobRunID: jrid},
+		ID:         jrid,
+		JobID:      j.ID,
+		JobVersion: j.Version,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		TaskRuns:   taskRuns,
+		Initiator:  i,
+		Status:     RunStatusUnstarted,
+		Result:     RunResult{JobRunID: jrid},
+	}
+}
+
+// jobSpecRequestFields copies each top level field of a JobSpecRequest,
+// keyed by its JSON name.
+var jobSpecRequestFields = map[string]func(dst *JobSpecRequest, src JobSpecRequest){
+	"name":        func(dst *JobSpecRequest, src JobSpecRequest) { dst.Name = src.Name },
+	"description": func(dst *JobSpecRequest, src JobSpecRequest) { dst.Description = src.Description },
+	"tags":        func(dst *JobSpecRequest, src JobSpecRequest) { dst.Tags = src.Tags },
+	"initiators":  func(dst *JobSpecRequest, src JobSpecRequest) { dst.Initiators = src.Initiators },
+	"tasks":       func(dst *JobSpecRequest, src JobSpecRequest) { dst.Tasks = src.Tasks },
+	"startAt":     func(dst *JobSpecRequest, src JobSpecRequest) { dst.StartAt = src.StartAt },
+	"endAt":       func(dst *JobSpecRequest, src JobSpecRequest) { dst.EndAt = src.EndAt },
+}
+
+// NewVersion returns the next version of the job, with the top level fields
+// present in the JSON encoded JobSpecRequest patch replacing its own.
+// Replaced initiators lose their IDs so that they are saved anew.
+func (j JobSpec) NewVersion(patch []byte) (JobSpec, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &fields); err != nil {
+		return j, err
+	}
+	var jsr JobSpecRequest
+	if err := json.Unmarshal(patch, &jsr); err != nil {
+		return j, err
+	}
+
+	next := j
+	next.Version = j.Version + 1
+	for name := range fields {
+		copyField, ok := jobSpecRequestFields[name]
+		if !ok {
+			return j, fmt.Errorf("cannot update job spec field %v", name)
+		}
+		copyField(&next.JobSpecRequest, jsr)
 	}
+
+	initrs := make([]Initiator, len(next.Initiators))
+	for i, initr := range next.Initiators {
+		if _, ok := fields["initiators"]; ok {
+			initr.ID = 0
+		}
+		initr.JobID = j.ID
+		initrs[i] = initr
+	}
+	next.Initiators = initrs
+	return next, nil
 }
 
 // InitiatorsFor returns an array of Initiators for the given list of
@@ -117,6 +178,69 @@ func (j JobSpec) WebAuthorized() bool {
 	return false
 }
 
+// Location returns the time zone the initiator's schedule is interpreted in,
+// which is the node's local time zone unless TimeZone is set.
+func (i Initiator) Location() (*time.Location, error) {
+	if i.TimeZone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(i.TimeZone)
+}
+
+// CronSchedule returns the schedule of a "cron" initiator in its time zone.
+func (i Initiator) CronSchedule() (cron.Schedule, error) {
+	loc, err := i.Location()
+	if err != nil {
+		return nil, err
+	}
+	return i.Schedule.Schedule(loc)
+}
+
+// NextFireAt returns the next time after t that a "cron" initiator fires,
+// before any jitter is applied.
+func (i Initiator) NextFireAt(t time.Time) (time.Time, error) {
+	s, err := i.CronSchedule()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return s.Next(t), nil
+}
+
+// MissedFires returns up to limit times a "cron" initiator should have fired
+// between its last recorded fire and now, oldest first, and whether there
+// were more than limit of them.
+func (i Initiator) MissedFires(state InitiatorState, now time.Time, limit int) ([]time.Time, bool, error) {
+	if !state.LastFiredAt.Valid {
+		return nil, false, nil
+	}
+	s, err := i.CronSchedule()
+	if err != nil {
+		return nil, false, err
+	}
+	missed := []time.Time{}
+	for next := s.Next(state.LastFiredAt.Time); !next.IsZero() && !next.After(now); next = s.Next(next) {
+		if len(missed) == limit {
+			return missed, true, nil
+		}
+		missed = append(missed, next)
+	}
+	return missed, false, nil
+}
+
+// InitiatorState is what the node records about an initiator as it runs,
+// kept apart from the initiator so that it is not part of the job spec.
+type InitiatorState struct {
+	InitiatorID    int       `json:"initiatorId" storm:"id,unique"`
+	LastFiredAt    null.Time `json:"lastFiredAt"`
+	LastFiredBlock uint64    `json:"lastFiredBlock"`
+}
+
+// IsBlockInterval returns true for an "interval" initiator that is
+// triggered every number of blocks rather than every period of time.
+func (i Initiator) IsBlockInterval() bool {
+	return i.Type == InitiatorInterval && i.Blocks > 0
+}
+
 // IsLogInitiated Returns true if any of the job's initiators are triggered by event logs.
 func (j JobSpec) IsLogInitiated() bool {
 	for _, initr := range j.Initiators {
@@ -143,6 +267,93 @@ func (j JobSpec) Started(t time.Time) bool {
 	return t.After(j.StartAt.Time) || t.Equal(j.StartAt.Time)
 }
 
+// HasTags returns true if the job has every one of the given tags.
+func (j JobSpec) HasTags(tags ...string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, t := range j.Tags {
+			if t == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Archived returns true if the job has been archived.
+func (j JobSpec) Archived() bool {
+	return j.ArchivedAt.Valid
+}
+
+// JobSpecTag records that a job has a tag, so that the jobs with a tag can be
+// found through the Tag index without loading every job.
+type JobSpecTag struct {
+	ID        string `json:"id" storm:"id,unique"`
+	Tag       string `json:"tag" storm:"index"`
+	JobSpecID string `json:"jobSpecId" storm:"index"`
+	CreatedAt Time   `json:"createdAt"`
+}
+
+// NewJobSpecTags returns a JobSpecTag for each of the job's tags.
+func NewJobSpecTags(j JobSpec) []JobSpecTag {
+	tags := make([]JobSpecTag, len(j.Tags))
+	for i, tag := range j.Tags {
+		tags[i] = JobSpecTag{
+			ID:        fmt.Sprintf("%s-%s", j.ID, tag),
+			Tag:       tag,
+			JobSpecID: j.ID,
+			CreatedAt: j.CreatedAt,
+		}
+	}
+	return tags
+}
+
+// JobSpecVersion is an immutable copy of a job as it was saved at one of its
+// versions, kept so that runs can be traced back to what they executed.
+type JobSpecVersion struct {
+	ID        string    `json:"id" storm:"id,unique"`
+	JobID     string    `json:"jobId" storm:"index"`
+	Version   uint32    `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	JobSpecRequest
+}
+
+// NewJobSpecVersion returns a copy of the job at its current version.
+func NewJobSpecVersion(j JobSpec) JobSpecVersion {
+	return JobSpecVersion{
+		ID:             JobSpecVersionID(j.ID, j.Version),
+		JobID:          j.ID,
+		Version:        j.Version,
+		CreatedAt:      time.Now(),
+		JobSpecRequest: j.JobSpecRequest,
+	}
+}
+
+// JobSpecVersionID returns the ID of the given version of a job.
+func JobSpecVersionID(jobID string, version uint32) string {
+	return fmt.Sprintf("%s-%d", jobID, version)
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (v JobSpecVersion) GetID() string {
+	return v.ID
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (v JobSpecVersion) GetName() string {
+	return "specVersions"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (v *JobSpecVersion) SetID(value string) error {
+	v.ID = value
+	return nil
+}
+
 // Types of Initiators (see Initiator struct just below.)
 const (
 	// InitiatorRunLog for tasks in a job to watch an ethereum address
@@ -159,6 +370,20 @@ const (
 	// InitiatorServiceAgreementExecutionLog for tasks in a job to watch a
 	// Solidity Coordinator contract and expect a payload from a log event.
 	InitiatorServiceAgreementExecutionLog = "execagreement"
+	// InitiatorInterval for tasks in a job to be ran every Period of time
+	// or every number of Blocks.
+	InitiatorInterval = "interval"
+)
+
+// Catch-up policies for runs of a "cron" initiator that were missed while
+// the node was down.
+const (
+	// CatchUpSkip drops missed runs. It is the default.
+	CatchUpSkip = "skip"
+	// CatchUpOnce starts a single run in place of all missed runs.
+	CatchUpOnce = "once"
+	// CatchUpAll starts a run for every missed run.
+	CatchUpAll = "all"
 )
 
 // Initiator could be thought of as a trigger, defines how a Job can be
@@ -177,10 +402,15 @@ type Initiator struct {
 // Initiators may require.
 type InitiatorParams struct {
 	Schedule   Cron             `json:"schedule,omitempty"`
+	TimeZone   string           `json:"timeZone,omitempty"`
+	Jitter     Duration         `json:"jitter,omitempty"`
+	CatchUp    string           `json:"catchUp,omitempty"`
 	Time       Time             `json:"time,omitempty"`
 	Ran        bool             `json:"ran,omitempty"`
 	Address    common.Address   `json:"address,omitempty" storm:"index"`
 	Requesters []common.Address `json:"requesters,omitempty"`
+	Period     Duration         `json:"period,omitempty"`
+	Blocks     uint64           `json:"blocks,omitempty"`
 }
 
 // UnmarshalJSON parses the raw initiator data and updates the
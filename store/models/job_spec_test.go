@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	null "gopkg.in/guregu/null.v3"
 )
 
@@ -46,6 +47,63 @@ func TestJobSpec_NewRun(t *testing.T) {
 	assert.Equal(t, initr, run.Initiator)
 }
 
+func TestJobSpec_NewRun_recordsVersion(t *testing.T) {
+	t.Parallel()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Version = 3
+
+	run := job.NewRun(initr)
+	assert.Equal(t, uint32(3), run.JobVersion)
+}
+
+func TestJobSpec_NewVersion(t *testing.T) {
+	t.Parallel()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	initr.ID = 7
+	job.Initiators = []models.Initiator{initr}
+	job.Name = "eth-usd"
+	job.Tags = []string{"eth"}
+	job.Version = 1
+
+	tests := []struct {
+		name      string
+		patch     string
+		wantName  string
+		wantTags  []string
+		wantInitr int
+		wantError bool
+	}{
+		{"name only", `{"name":"btc-usd"}`, "btc-usd", []string{"eth"}, 7, false},
+		{"clears tags", `{"tags":[]}`, "eth-usd", []string{}, 7, false},
+		{"new initiators", `{"initiators":[{"type":"web"}]}`, "eth-usd", []string{"eth"}, 0, false},
+		{"unknown field", `{"id":"other"}`, "", nil, 0, true},
+		{"invalid json", `{"name":`, "", nil, 0, true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			next, err := job.NewVersion([]byte(test.patch))
+			if test.wantError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, job.ID, next.ID)
+			assert.Equal(t, uint32(2), next.Version)
+			assert.Equal(t, test.wantName, next.Name)
+			assert.Equal(t, test.wantTags, next.Tags)
+			require.Len(t, next.Initiators, 1)
+			assert.Equal(t, test.wantInitr, next.Initiators[0].ID)
+			assert.Equal(t, job.ID, next.Initiators[0].JobID)
+		})
+	}
+
+	assert.Equal(t, 7, job.Initiators[0].ID)
+}
+
 func TestJobEnded(t *testing.T) {
 	t.Parallel()
 
@@ -154,3 +212,45 @@ func TestBridgeType_Authenticate(t *testing.T) {
 		})
 	}
 }
+
+func TestInitiator_NextFireAt_TimeZone(t *testing.T) {
+	t.Parallel()
+
+	_, initr := cltest.NewJobWithSchedule("0 0 9 * * *")
+	initr.TimeZone = "Asia/Tokyo"
+
+	next, err := initr.NextFireAt(cltest.ParseISO8601("2019-01-01T01:00:00.000Z"))
+	require.NoError(t, err)
+	assert.Equal(t, cltest.ParseISO8601("2019-01-02T00:00:00.000Z").Unix(), next.Unix())
+
+	initr.TimeZone = "Nowhere/Special"
+	_, err = initr.NextFireAt(time.Now())
+	assert.Error(t, err)
+}
+
+func TestInitiator_MissedFires(t *testing.T) {
+	t.Parallel()
+
+	now := cltest.ParseISO8601("2019-01-01T12:30:00.000Z")
+	_, initr := cltest.NewJobWithSchedule("0 0 * * * *")
+	initr.TimeZone = "UTC"
+
+	state := models.InitiatorState{InitiatorID: initr.ID}
+	missed, truncated, err := initr.MissedFires(state, now, 10)
+	require.NoError(t, err)
+	assert.Empty(t, missed)
+	assert.False(t, truncated)
+
+	state.LastFiredAt = null.TimeFrom(now.Add(-3 * time.Hour))
+	missed, truncated, err = initr.MissedFires(state, now, 10)
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	require.Equal(t, 3, len(missed))
+	assert.Equal(t, cltest.ParseISO8601("2019-01-01T10:00:00.000Z").Unix(), missed[0].Unix())
+	assert.Equal(t, cltest.ParseISO8601("2019-01-01T12:00:00.000Z").Unix(), missed[2].Unix())
+
+	missed, truncated, err = initr.MissedFires(state, now, 2)
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Equal(t, 2, len(missed))
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/utils"
+)
+
+// LinkSweep records a withdrawal of earned LINK from the oracle contract to
+// the configured cold address.
+type LinkSweep struct {
+	ID                 string         `json:"id" storm:"id,unique"`
+	ContractAddress    common.Address `json:"contractAddress"`
+	DestinationAddress common.Address `json:"destinationAddress"`
+	Balance            *assets.Link   `json:"balance"`
+	Amount             *assets.Link   `json:"amount"`
+	TxHash             common.Hash    `json:"txHash"`
+	BlockNumber        uint64         `json:"blockNumber"`
+	CreatedAt          Time           `json:"createdAt" storm:"index"`
+}
+
+// NewLinkSweep returns a LinkSweep with a generated ID.
+func NewLinkSweep() LinkSweep {
+	return LinkSweep{
+		ID:        utils.NewBytes32ID(),
+		CreatedAt: Time{Time: time.Now()},
+	}
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (ls LinkSweep) GetID() string {
+	return ls.ID
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (ls LinkSweep) GetName() string {
+	return "link_sweeps"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (ls *LinkSweep) SetID(value string) error {
+	ls.ID = value
+	return nil
+}
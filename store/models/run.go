@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/tidwall/gjson"
@@ -26,6 +27,12 @@ type JobRun struct {
 	CreationHeight *hexutil.Big `json:"creationHeight"`
 	ObservedHeight *hexutil.Big `json:"observedHeight"`
 	Overrides      RunResult    `json:"overrides"`
+	// Requester is the address which requested the run on chain, when
+	// initiated by a run log.
+	Requester *common.Address `json:"requester,omitempty" storm:"index"`
+	// JobVersion is the version of the job that was run, zero for runs
+	// created before jobs were versioned.
+	JobVersion uint32 `json:"jobVersion"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -177,6 +184,9 @@ type RunResult struct {
 	Status       RunStatus    `json:"status"`
 	ErrorMessage null.String  `json:"error"`
 	Amount       *assets.Link `json:"amount,omitempty"`
+	// Skipped is set when a task completes the run without running the
+	// tasks after it.
+	Skipped bool `json:"skipped,omitempty"`
 }
 
 // WithValue returns a copy of the RunResult, overriding the "value" field of
@@ -215,6 +225,14 @@ func (rr RunResult) WithError(err error) RunResult {
 	return rr
 }
 
+// MarkSkipped returns a copy of RunResult marked as completed, skipping the
+// remaining tasks of the run.
+func (rr RunResult) MarkSkipped() RunResult {
+	rr.Status = RunStatusCompleted
+	rr.Skipped = true
+	return rr
+}
+
 // MarkPendingBridge returns a copy of RunResult but with status set to pending_bridge.
 func (rr RunResult) MarkPendingBridge() RunResult {
 	rr.Status = RunStatusPendingBridge
@@ -233,6 +251,12 @@ func (rr RunResult) MarkPendingConnection() RunResult {
 	return rr
 }
 
+// MarkPendingFunds returns a copy of RunResult but with status set to pending_funds.
+func (rr RunResult) MarkPendingFunds() RunResult {
+	rr.Status = RunStatusPendingFunds
+	return rr
+}
+
 // Get searches for and returns the JSON at the given path.
 func (rr RunResult) Get(path string) gjson.Result {
 	return rr.Data.Get(path)
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/utils"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // UnsignedServiceAgreement contains the information to sign a service agreement
@@ -21,6 +22,7 @@ type UnsignedServiceAgreement struct {
 	Encumbrance    Encumbrance
 	ID             common.Hash
 	RequestBody    string
+	RequestDigest  common.Hash
 	JobSpecRequest JobSpecRequest
 }
 
@@ -32,10 +34,75 @@ type ServiceAgreement struct {
 	JobSpecID   string      `json:"jobSpecID"`
 	RequestBody string      `json:"requestBody"`
 	Signature   Signature   `json:"signature"`
-	JobSpec     JobSpec     // JobSpec is used during the initial SA creation.
+	// SigningAddress is the account the agreement was signed with, which
+	// stays valid for verification after the node rotates to a new key.
+	SigningAddress common.Address `json:"signingAddress"`
+	// CreatedBlockNumber is the node's head when the agreement was saved, the
+	// earliest block its initiation can be found in.
+	CreatedBlockNumber uint64 `json:"createdBlockNumber"`
+	// InitiatedTxHash and InitiatedBlockNumber record the NewServiceAgreement
+	// event emitted when the agreement was initiated on the Coordinator
+	// contract, and are empty until it is observed.
+	InitiatedTxHash      common.Hash `json:"initiatedTxHash"`
+	InitiatedBlockNumber uint64      `json:"initiatedBlockNumber"`
+	JobSpec              JobSpec     // JobSpec is used during the initial SA creation.
 	// If needed later, it can be retrieved from the database with JobSpecID.
 }
 
+// ServiceAgreementStatus describes where a service agreement is in its
+// lifecycle.
+type ServiceAgreementStatus string
+
+const (
+	// ServiceAgreementStatusPending is used for agreements signed by this node
+	// but not yet initiated on the Coordinator contract.
+	ServiceAgreementStatusPending = ServiceAgreementStatus("pending")
+	// ServiceAgreementStatusInitiated is used for agreements initiated on the
+	// Coordinator contract.
+	ServiceAgreementStatusInitiated = ServiceAgreementStatus("initiated")
+	// ServiceAgreementStatusExpired is used for agreements past their endAt.
+	ServiceAgreementStatusExpired = ServiceAgreementStatus("expired")
+)
+
+// Initiated returns true if the agreement has been seen being initiated on
+// the Coordinator contract.
+func (sa ServiceAgreement) Initiated() bool {
+	return sa.InitiatedTxHash != common.Hash{}
+}
+
+// Expired returns true if the agreement's endAt is before the given time.
+func (sa ServiceAgreement) Expired(t time.Time) bool {
+	return !sa.Encumbrance.EndAt.IsZero() && t.After(sa.Encumbrance.EndAt.Time)
+}
+
+// Status returns the agreement's status at the given time.
+func (sa ServiceAgreement) Status(t time.Time) ServiceAgreementStatus {
+	if sa.Expired(t) {
+		return ServiceAgreementStatusExpired
+	} else if sa.Initiated() {
+		return ServiceAgreementStatusInitiated
+	}
+	return ServiceAgreementStatusPending
+}
+
+// RecoverSigner returns the address of the account that made the
+// agreement's signature.
+func (sa ServiceAgreement) RecoverSigner() (common.Address, error) {
+	return sa.Signature.RecoverAddress(common.HexToHash(sa.ID).Bytes())
+}
+
+// VerifySigner returns an error unless the agreement's signature was made by
+// the given address.
+func (sa ServiceAgreement) VerifySigner(address common.Address) error {
+	signer, err := sa.RecoverSigner()
+	if err != nil {
+		return err
+	} else if signer != address {
+		return fmt.Errorf("agreement %s was signed by %s, not %s", sa.ID, signer.Hex(), address.Hex())
+	}
+	return nil
+}
+
 // GetID returns the ID of this structure for jsonapi serialization.
 func (sa ServiceAgreement) GetID() string {
 	return sa.ID
@@ -56,6 +123,7 @@ func (sa *ServiceAgreement) SetID(value string) error {
 // Signer is used to produce a HMAC signature from an input digest
 type Signer interface {
 	Sign(input []byte) (Signature, error)
+	Address() common.Address
 }
 
 // BuildServiceAgreement builds a signed service agreement
@@ -64,13 +132,19 @@ func BuildServiceAgreement(us UnsignedServiceAgreement, signer Signer) (ServiceA
 	if err != nil {
 		return ServiceAgreement{}, err
 	}
+	jobSpec := NewJobFromRequest(us.JobSpecRequest)
+	if !us.Encumbrance.EndAt.IsZero() {
+		// The job must stop running when the agreement expires
+		jobSpec.EndAt = null.TimeFrom(us.Encumbrance.EndAt.Time)
+	}
 	return ServiceAgreement{
-		ID:          us.ID.String(),
-		CreatedAt:   Time{time.Now()},
-		Encumbrance: us.Encumbrance,
-		JobSpec:     NewJobFromRequest(us.JobSpecRequest),
-		RequestBody: us.RequestBody,
-		Signature:   signature,
+		ID:             us.ID.String(),
+		CreatedAt:      Time{time.Now()},
+		Encumbrance:    us.Encumbrance,
+		JobSpec:        jobSpec,
+		RequestBody:    us.RequestBody,
+		Signature:      signature,
+		SigningAddress: signer.Address(),
 	}, nil
 }
 
@@ -113,6 +187,7 @@ func NewUnsignedServiceAgreementFromRequest(reader io.Reader) (UnsignedServiceAg
 		ID:             id,
 		Encumbrance:    encumbrance,
 		RequestBody:    normalized,
+		RequestDigest:  common.BytesToHash(requestDigest),
 		JobSpecRequest: jsr,
 	}
 
@@ -148,6 +223,144 @@ func serviceAgreementIDInputBuffer(encumbrance Encumbrance, digest common.Hash)
 	return buffer, nil
 }
 
+// RecoverSigner returns the address of the account that made the signature
+// over the agreement.
+func (us UnsignedServiceAgreement) RecoverSigner(signature Signature) (common.Address, error) {
+	return signature.RecoverAddress(us.ID.Bytes())
+}
+
+// VerifyServiceAgreementRequest holds an agreement request and a signature
+// over it to check. Address is optional, and when set the signature must
+// have been made by that account.
+type VerifyServiceAgreementRequest struct {
+	Agreement json.RawMessage `json:"agreement"`
+	Signature Signature       `json:"signature"`
+	Address   common.Address  `json:"address"`
+}
+
+// ServiceAgreementVerification is the result of checking a signature over an
+// agreement. It is valid when the signer is one of the agreement's oracles
+// and, if an address was expected, is that address.
+type ServiceAgreementVerification struct {
+	ID     common.Hash    `json:"id"`
+	Signer common.Address `json:"signer"`
+	Oracle bool           `json:"oracle"`
+	Valid  bool           `json:"valid"`
+}
+
+// VerifyServiceAgreement recovers the signer of the signature over the
+// agreement and checks it against the agreement's oracles and the expected
+// address, which is ignored when empty.
+func VerifyServiceAgreement(
+	us UnsignedServiceAgreement,
+	signature Signature,
+	expected common.Address,
+) (ServiceAgreementVerification, error) {
+	signer, err := us.RecoverSigner(signature)
+	if err != nil {
+		return ServiceAgreementVerification{}, fmt.Errorf("invalid signature: %v", err)
+	}
+	oracle := us.Encumbrance.HasOracle(signer)
+	return ServiceAgreementVerification{
+		ID:     us.ID,
+		Signer: signer,
+		Oracle: oracle,
+		Valid:  oracle && (expected == common.Address{} || expected == signer),
+	}, nil
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (sav ServiceAgreementVerification) GetID() string {
+	return sav.ID.Hex()
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (sav ServiceAgreementVerification) GetName() string {
+	return "service_agreement_verifications"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (sav *ServiceAgreementVerification) SetID(value string) error {
+	sav.ID = common.HexToHash(value)
+	return nil
+}
+
+// CoordinateServiceAgreementRequest asks the node to collect signatures for
+// the agreement from the nodes at the listed URLs.
+type CoordinateServiceAgreementRequest struct {
+	Agreement json.RawMessage `json:"agreement"`
+	Nodes     []WebURL        `json:"nodes"`
+}
+
+// CoordinatorServiceAgreement holds the arguments to the Coordinator
+// contract's initiateServiceAgreement, with a signature from every oracle in
+// the order they are listed.
+type CoordinatorServiceAgreement struct {
+	ID            common.Hash      `json:"id"`
+	Payment       *assets.Link     `json:"payment"`
+	Expiration    uint64           `json:"expiration"`
+	EndAt         uint64           `json:"endAt"`
+	Oracles       []common.Address `json:"oracles"`
+	Vs            []uint64         `json:"vs"`
+	Rs            []common.Hash    `json:"rs"`
+	Ss            []common.Hash    `json:"ss"`
+	RequestDigest common.Hash      `json:"requestDigest"`
+}
+
+// NewCoordinatorServiceAgreement combines the oracles' signatures of the
+// agreement, returning an error if any oracle has not signed it.
+func NewCoordinatorServiceAgreement(
+	us UnsignedServiceAgreement,
+	signatures map[common.Address]Signature,
+) (CoordinatorServiceAgreement, error) {
+	csa := CoordinatorServiceAgreement{
+		ID:            us.ID,
+		Payment:       us.Encumbrance.Payment,
+		Expiration:    us.Encumbrance.Expiration,
+		EndAt:         uint64(us.Encumbrance.EndAt.Unix()),
+		RequestDigest: us.RequestDigest,
+	}
+
+	var missing []string
+	for _, oracle := range us.Encumbrance.Oracles {
+		address := oracle.Address()
+		signature, ok := signatures[address]
+		if !ok {
+			missing = append(missing, address.Hex())
+			continue
+		}
+		// ecrecover expects v to be 27 or 28
+		v := uint64(signature[SignatureLength-1])
+		if v < 27 {
+			v += 27
+		}
+		csa.Oracles = append(csa.Oracles, address)
+		csa.Vs = append(csa.Vs, v)
+		csa.Rs = append(csa.Rs, common.BytesToHash(signature[:32]))
+		csa.Ss = append(csa.Ss, common.BytesToHash(signature[32:64]))
+	}
+	if len(missing) > 0 {
+		return CoordinatorServiceAgreement{}, fmt.Errorf("missing signatures from oracles %v", missing)
+	}
+	return csa, nil
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (csa CoordinatorServiceAgreement) GetID() string {
+	return csa.ID.Hex()
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (csa CoordinatorServiceAgreement) GetName() string {
+	return "coordinator_service_agreements"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (csa *CoordinatorServiceAgreement) SetID(value string) error {
+	csa.ID = common.HexToHash(value)
+	return nil
+}
+
 // Encumbrance connects job specifications with on-chain encumbrances.
 type Encumbrance struct {
 	Payment    *assets.Link   `json:"payment"`
@@ -156,6 +369,16 @@ type Encumbrance struct {
 	Oracles    []EIP55Address `json:"oracles"`
 }
 
+// HasOracle returns true if the address is one of the participating oracles.
+func (e Encumbrance) HasOracle(address common.Address) bool {
+	for _, oracle := range e.Oracles {
+		if oracle.Address() == address {
+			return true
+		}
+	}
+	return false
+}
+
 // ABI packs the encumberance as a byte array using the same technique as
 // abi.encodePacked, meaning that addresses are padded with left 0s to match
 // hashes in the oracle list
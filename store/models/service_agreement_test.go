@@ -1,16 +1,22 @@
 package models_test
 
 import (
+	"crypto/ecdsa"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewUnsignedServiceAgreementFromRequest(t *testing.T) {
@@ -82,6 +88,167 @@ func TestBuildServiceAgreement(t *testing.T) {
 	}
 }
 
+func TestBuildServiceAgreement_EndsJobWithAgreement(t *testing.T) {
+	t.Parallel()
+
+	input := `{"payment":"1","initiators":[{"type":"execagreement"}],"tasks":[{"type":"noop"}],"endAt":"2019-10-19T22:17:19Z"}`
+	us, err := models.NewUnsignedServiceAgreementFromRequest(strings.NewReader(input))
+	assert.NoError(t, err)
+
+	sa, err := models.BuildServiceAgreement(us, cltest.MockSigner{})
+	assert.NoError(t, err)
+	assert.True(t, sa.JobSpec.EndAt.Valid)
+	assert.True(t, sa.Encumbrance.EndAt.Time.Equal(sa.JobSpec.EndAt.Time))
+}
+
+func TestServiceAgreement_Status(t *testing.T) {
+	t.Parallel()
+
+	endAt := time.Now()
+	tests := []struct {
+		name      string
+		endAt     models.Time
+		initiated bool
+		at        time.Time
+		want      models.ServiceAgreementStatus
+	}{
+		{"pending", models.Time{Time: endAt}, false, endAt.Add(-time.Minute), models.ServiceAgreementStatusPending},
+		{"initiated", models.Time{Time: endAt}, true, endAt.Add(-time.Minute), models.ServiceAgreementStatusInitiated},
+		{"expired", models.Time{Time: endAt}, true, endAt.Add(time.Minute), models.ServiceAgreementStatusExpired},
+		{"no end", models.Time{}, false, endAt.Add(time.Minute), models.ServiceAgreementStatusPending},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sa := models.ServiceAgreement{Encumbrance: models.Encumbrance{EndAt: test.endAt}}
+			if test.initiated {
+				sa.InitiatedTxHash = cltest.NewHash()
+			}
+			assert.Equal(t, test.initiated, sa.Initiated())
+			assert.Equal(t, test.want, sa.Status(test.at))
+		})
+	}
+}
+
+func TestNewCoordinatorServiceAgreement(t *testing.T) {
+	t.Parallel()
+
+	key1, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	oracle1 := crypto.PubkeyToAddress(key1.PublicKey)
+	oracle2 := crypto.PubkeyToAddress(key2.PublicKey)
+
+	input := fmt.Sprintf(`{"payment":"1","expiration":300,"endAt":"2019-10-19T22:17:19Z","oracles":["%s","%s"],`+
+		`"initiators":[{"type":"execagreement"}],"tasks":[{"type":"noop"}]}`, oracle1.Hex(), oracle2.Hex())
+	us, err := models.NewUnsignedServiceAgreementFromRequest(strings.NewReader(input))
+	require.NoError(t, err)
+	digest, err := utils.Keccak256([]byte(us.RequestBody))
+	require.NoError(t, err)
+	assert.Equal(t, common.BytesToHash(digest), us.RequestDigest)
+
+	sign := func(key *ecdsa.PrivateKey) models.Signature {
+		hash, err := utils.Keccak256(us.ID.Bytes())
+		require.NoError(t, err)
+		output, err := crypto.Sign(hash, key)
+		require.NoError(t, err)
+		return models.BytesToSignature(output)
+	}
+	signatures := map[common.Address]models.Signature{oracle2: sign(key2)}
+
+	_, err = models.NewCoordinatorServiceAgreement(us, signatures)
+	assert.Error(t, err, "expected missing signatures to be reported")
+
+	signatures[oracle1] = sign(key1)
+	csa, err := models.NewCoordinatorServiceAgreement(us, signatures)
+	require.NoError(t, err)
+	assert.Equal(t, us.ID, csa.ID)
+	assert.Equal(t, assets.NewLink(1), csa.Payment)
+	assert.Equal(t, uint64(300), csa.Expiration)
+	assert.Equal(t, uint64(1571523439), csa.EndAt)
+	assert.Equal(t, []common.Address{oracle1, oracle2}, csa.Oracles)
+	require.Len(t, csa.Vs, 2)
+	for i, oracle := range []common.Address{oracle1, oracle2} {
+		assert.Contains(t, []uint64{27, 28}, csa.Vs[i])
+		signature := signatures[oracle]
+		assert.Equal(t, common.BytesToHash(signature[:32]), csa.Rs[i])
+		assert.Equal(t, common.BytesToHash(signature[32:64]), csa.Ss[i])
+	}
+}
+
+func TestVerifyServiceAgreement(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	outsider, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	oracle := crypto.PubkeyToAddress(key.PublicKey)
+	other := cltest.NewAddress()
+
+	input := fmt.Sprintf(`{"payment":"1","expiration":300,"endAt":"2019-10-19T22:17:19Z","oracles":["%s","%s"],`+
+		`"initiators":[{"type":"execagreement"}],"tasks":[{"type":"noop"}]}`, oracle.Hex(), other.Hex())
+	us, err := models.NewUnsignedServiceAgreementFromRequest(strings.NewReader(input))
+	require.NoError(t, err)
+
+	sign := func(key *ecdsa.PrivateKey) models.Signature {
+		hash, err := utils.Keccak256(us.ID.Bytes())
+		require.NoError(t, err)
+		output, err := crypto.Sign(hash, key)
+		require.NoError(t, err)
+		return models.BytesToSignature(output)
+	}
+
+	tests := []struct {
+		name       string
+		signature  models.Signature
+		expected   common.Address
+		wantSigner common.Address
+		wantOracle bool
+		wantValid  bool
+	}{
+		{"signed by oracle", sign(key), common.Address{}, oracle, true, true},
+		{"signed by expected oracle", sign(key), oracle, oracle, true, true},
+		{"signed by other oracle", sign(key), other, oracle, true, false},
+		{"signed by outsider", sign(outsider), common.Address{}, crypto.PubkeyToAddress(outsider.PublicKey), false, false},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			sav, err := models.VerifyServiceAgreement(us, test.signature, test.expected)
+			require.NoError(t, err)
+			assert.Equal(t, us.ID, sav.ID)
+			assert.Equal(t, test.wantSigner, sav.Signer)
+			assert.Equal(t, test.wantOracle, sav.Oracle)
+			assert.Equal(t, test.wantValid, sav.Valid)
+		})
+	}
+
+	_, err = models.VerifyServiceAgreement(us, models.Signature{}, common.Address{})
+	assert.Error(t, err)
+
+	sa, err := models.BuildServiceAgreement(us, cltest.KeySigner{Key: key})
+	require.NoError(t, err)
+	signer, err := sa.RecoverSigner()
+	require.NoError(t, err)
+	assert.Equal(t, oracle, signer)
+	assert.NoError(t, sa.VerifySigner(oracle))
+	assert.Error(t, sa.VerifySigner(other))
+}
+
+func TestEncumbrance_HasOracle(t *testing.T) {
+	t.Parallel()
+
+	oracle := cltest.NewAddress()
+	var encumbrance models.Encumbrance
+	require.NoError(t, json.Unmarshal([]byte(fmt.Sprintf(`{"oracles":["%s"]}`, oracle.Hex())), &encumbrance))
+
+	assert.True(t, encumbrance.HasOracle(oracle))
+	assert.False(t, encumbrance.HasOracle(cltest.NewAddress()))
+}
+
 func TestEncumbrance_ABI(t *testing.T) {
 	t.Parallel()
 	endAt, _ := time.Parse("2006-01-02T15:04:05.000Z", "2007-01-02T15:04:05.000Z")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/smartcontractkit/chainlink/utils"
 )
 
@@ -51,6 +52,25 @@ func (s Signature) Format(state fmt.State, c rune) {
 	fmt.Fprintf(state, "%"+string(c), s.String())
 }
 
+// RecoverAddress returns the address of the account that signed the input.
+// The input is hashed with Keccak256 first, as KeyStore.SignWith does. The
+// recovery id may be either 0/1 or, as produced by Ethereum wallets, 27/28.
+func (s Signature) RecoverAddress(input []byte) (common.Address, error) {
+	hash, err := utils.Keccak256(input)
+	if err != nil {
+		return common.Address{}, err
+	}
+	sig := s.Bytes()
+	if sig[SignatureLength-1] >= 27 {
+		sig[SignatureLength-1] -= 27
+	}
+	pubkey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubkey), nil
+}
+
 // SetBytes assigns the byte array to the signature
 func (s *Signature) SetBytes(b []byte) {
 	if len(b) > len(s) {
@@ -6,7 +6,10 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSignature(t *testing.T) {
@@ -40,3 +43,30 @@ func TestSignature(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, str, zerosignature.String())
 }
+
+func TestSignature_RecoverAddress(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	input := []byte("service agreement")
+	hash, err := utils.Keccak256(input)
+	require.NoError(t, err)
+	output, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	signature := BytesToSignature(output)
+
+	address, err := signature.RecoverAddress(input)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), address)
+
+	output[SignatureLength-1] += 27
+	address, err = BytesToSignature(output).RecoverAddress(input)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), address, "expected a recovery id of 27/28 to be accepted")
+
+	address, err = signature.RecoverAddress([]byte("another agreement"))
+	require.NoError(t, err)
+	assert.NotEqual(t, crypto.PubkeyToAddress(key.PublicKey), address)
+
+	_, err = Signature{}.RecoverAddress(input)
+	assert.Error(t, err)
+}
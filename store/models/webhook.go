@@ -0,0 +1,271 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/utils"
+	null "gopkg.in/guregu/null.v3"
+)
+
+// WebhookEvent is the name of a job run lifecycle event a webhook can
+// subscribe to.
+type WebhookEvent string
+
+const (
+	// WebhookEventRunCompleted is fired when a run finishes successfully.
+	WebhookEventRunCompleted = WebhookEvent("run.completed")
+	// WebhookEventRunErrored is fired when a run stops because of an error.
+	WebhookEventRunErrored = WebhookEvent("run.errored")
+	// WebhookEventRunPending is fired when a run has been left in a pending
+	// state for longer than the configured threshold.
+	WebhookEventRunPending = WebhookEvent("run.pending")
+	// WebhookEventAccountLowBalance is fired when one of the node's accounts
+	// falls below the configured ETH balance threshold.
+	WebhookEventAccountLowBalance = WebhookEvent("account.low_balance")
+)
+
+// WebhookEventForStatus returns the webhook event fired when a run
+// transitions to the passed status, if any.
+func WebhookEventForStatus(status RunStatus) (WebhookEvent, bool) {
+	switch status {
+	case RunStatusCompleted:
+		return WebhookEventRunCompleted, true
+	case RunStatusErrored:
+		return WebhookEventRunErrored, true
+	default:
+		return "", false
+	}
+}
+
+// NewWebhookEvent validates and returns a WebhookEvent.
+func NewWebhookEvent(val string) (WebhookEvent, error) {
+	event := WebhookEvent(val)
+	switch event {
+	case WebhookEventRunCompleted, WebhookEventRunErrored, WebhookEventRunPending, WebhookEventAccountLowBalance:
+		return event, nil
+	default:
+		return "", fmt.Errorf("Webhook event validation: %v is not a supported event", val)
+	}
+}
+
+// UnmarshalJSON parses and validates a WebhookEvent.
+func (e *WebhookEvent) UnmarshalJSON(input []byte) error {
+	var aux string
+	if err := json.Unmarshal(input, &aux); err != nil {
+		return err
+	}
+	event, err := NewWebhookEvent(aux)
+	*e = event
+	return err
+}
+
+// WebhookSignatureHeader is the HTTP header carrying the hex encoded
+// HMAC-SHA256 of a delivery's body, keyed with the webhook's secret.
+const WebhookSignatureHeader = "X-Chainlink-Signature"
+
+// Webhook is an outbound HTTP endpoint notified of job run lifecycle events.
+// When Events is empty, the webhook receives every event.
+type Webhook struct {
+	ID        string         `json:"id" storm:"id,unique"`
+	URL       WebURL         `json:"url"`
+	Secret    string         `json:"secret"`
+	Events    []WebhookEvent `json:"events"`
+	CreatedAt Time           `json:"createdAt" storm:"index"`
+}
+
+// WebhookRequest is the API request to register a webhook. A secret is
+// generated when one is not supplied.
+type WebhookRequest struct {
+	URL    WebURL         `json:"url"`
+	Secret string         `json:"secret"`
+	Events []WebhookEvent `json:"events"`
+}
+
+// NewWebhook returns a Webhook with a generated ID.
+func NewWebhook() Webhook {
+	return Webhook{
+		ID:        utils.NewBytes32ID(),
+		CreatedAt: Time{Time: time.Now()},
+	}
+}
+
+// NewWebhookFromRequest validates the request and returns the Webhook it
+// describes.
+func NewWebhookFromRequest(request WebhookRequest) (Webhook, error) {
+	if request.URL.Host == "" {
+		return Webhook{}, errors.New("Webhook validation: url must be an absolute URL")
+	}
+
+	webhook := NewWebhook()
+	webhook.URL = request.URL
+	webhook.Secret = request.Secret
+	if webhook.Secret == "" {
+		webhook.Secret = utils.NewBytes32ID()
+	}
+	webhook.Events = request.Events
+	return webhook, nil
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (w Webhook) GetID() string {
+	return w.ID
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (w Webhook) GetName() string {
+	return "webhooks"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (w *Webhook) SetID(value string) error {
+	w.ID = value
+	return nil
+}
+
+// Subscribes returns true if the webhook should be notified of the event.
+func (w Webhook) Subscribes(event WebhookEvent) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the body using the webhook's
+// secret.
+func (w Webhook) Sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(w.Secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookDeliveryStatus indicates the state of an outbound webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending means the delivery has not succeeded yet
+	// and will be retried.
+	WebhookDeliveryStatusPending = WebhookDeliveryStatus("pending")
+	// WebhookDeliveryStatusDelivered means the endpoint acknowledged the
+	// delivery with a 2xx response.
+	WebhookDeliveryStatusDelivered = WebhookDeliveryStatus("delivered")
+	// WebhookDeliveryStatusFailed means every attempt failed and the delivery
+	// was abandoned.
+	WebhookDeliveryStatusFailed = WebhookDeliveryStatus("failed")
+)
+
+// WebhookPayload is the JSON body sent to a webhook endpoint.
+type WebhookPayload struct {
+	Event       WebhookEvent `json:"event"`
+	JobID       string       `json:"jobId"`
+	RunID       string       `json:"runId"`
+	Status      RunStatus    `json:"status"`
+	Error       null.String  `json:"error"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	CompletedAt null.Time    `json:"completedAt"`
+	Timestamp   time.Time    `json:"timestamp"`
+}
+
+// WebhookAccountPayload is the JSON body sent to a webhook endpoint for
+// events about one of the node's accounts.
+type WebhookAccountPayload struct {
+	Event     WebhookEvent   `json:"event"`
+	Address   common.Address `json:"address"`
+	Balance   *assets.Eth    `json:"balance"`
+	Threshold *assets.Eth    `json:"threshold"`
+	Timestamp time.Time      `json:"timestamp"`
+}
+
+// WebhookDelivery records each attempt to notify a webhook of an event.
+type WebhookDelivery struct {
+	ID            string                `json:"id" storm:"id,unique"`
+	WebhookID     string                `json:"webhookId" storm:"index"`
+	Event         WebhookEvent          `json:"event"`
+	RunID         string                `json:"runId" storm:"index"`
+	Body          string                `json:"body"`
+	Status        WebhookDeliveryStatus `json:"status" storm:"index"`
+	Attempts      uint64                `json:"attempts"`
+	ResponseCode  int                   `json:"responseCode"`
+	Error         null.String           `json:"error"`
+	CreatedAt     time.Time             `json:"createdAt" storm:"index"`
+	NextAttemptAt time.Time             `json:"nextAttemptAt"`
+	DeliveredAt   null.Time             `json:"deliveredAt"`
+}
+
+// NewWebhookDelivery builds a pending delivery of the event for the run.
+func NewWebhookDelivery(webhook Webhook, event WebhookEvent, run JobRun) (WebhookDelivery, error) {
+	now := time.Now()
+	body, err := json.Marshal(WebhookPayload{
+		Event:       event,
+		JobID:       run.JobID,
+		RunID:       run.ID,
+		Status:      run.Status,
+		Error:       run.Result.ErrorMessage,
+		CreatedAt:   run.CreatedAt,
+		CompletedAt: run.CompletedAt,
+		Timestamp:   now,
+	})
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+
+	return WebhookDelivery{
+		ID:            utils.NewBytes32ID(),
+		WebhookID:     webhook.ID,
+		Event:         event,
+		RunID:         run.ID,
+		Body:          string(body),
+		Status:        WebhookDeliveryStatusPending,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}, nil
+}
+
+// NewWebhookAccountDelivery builds a pending delivery of an event about one
+// of the node's accounts.
+func NewWebhookAccountDelivery(webhook Webhook, payload WebhookAccountPayload) (WebhookDelivery, error) {
+	now := time.Now()
+	payload.Timestamp = now
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+
+	return WebhookDelivery{
+		ID:            utils.NewBytes32ID(),
+		WebhookID:     webhook.ID,
+		Event:         payload.Event,
+		Body:          string(body),
+		Status:        WebhookDeliveryStatusPending,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}, nil
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (d WebhookDelivery) GetID() string {
+	return d.ID
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (d WebhookDelivery) GetName() string {
+	return "webhook_deliveries"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (d *WebhookDelivery) SetID(value string) error {
+	d.ID = value
+	return nil
+}
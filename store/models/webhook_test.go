@@ -0,0 +1,136 @@
+package models_test
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookEvent_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     string
+		want      models.WebhookEvent
+		wantError bool
+	}{
+		{"completed", `"run.completed"`, models.WebhookEventRunCompleted, false},
+		{"errored", `"run.errored"`, models.WebhookEventRunErrored, false},
+		{"pending", `"run.pending"`, models.WebhookEventRunPending, false},
+		{"low balance", `"account.low_balance"`, models.WebhookEventAccountLowBalance, false},
+		{"unknown", `"run.started"`, "", true},
+		{"not a string", `1`, "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var event models.WebhookEvent
+			err := json.Unmarshal([]byte(test.input), &event)
+			assert.Equal(t, test.wantError, err != nil)
+			assert.Equal(t, test.want, event)
+		})
+	}
+}
+
+func TestWebhook_Subscribes(t *testing.T) {
+	t.Parallel()
+
+	all := models.Webhook{}
+	assert.True(t, all.Subscribes(models.WebhookEventRunCompleted))
+	assert.True(t, all.Subscribes(models.WebhookEventRunPending))
+
+	errorsOnly := models.Webhook{Events: []models.WebhookEvent{models.WebhookEventRunErrored}}
+	assert.True(t, errorsOnly.Subscribes(models.WebhookEventRunErrored))
+	assert.False(t, errorsOnly.Subscribes(models.WebhookEventRunCompleted))
+}
+
+func TestWebhook_Sign(t *testing.T) {
+	t.Parallel()
+
+	webhook := models.Webhook{Secret: "key"}
+	assert.Equal(t,
+		"f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		webhook.Sign([]byte("The quick brown fox jumps over the lazy dog")),
+	)
+}
+
+func TestNewWebhookFromRequest(t *testing.T) {
+	t.Parallel()
+
+	webhook, err := models.NewWebhookFromRequest(models.WebhookRequest{
+		URL: cltest.WebURL("https://example.com/hook"),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, webhook.ID)
+	assert.NotEmpty(t, webhook.Secret, "expected a secret to be generated")
+
+	webhook, err = models.NewWebhookFromRequest(models.WebhookRequest{
+		URL:    cltest.WebURL("https://example.com/hook"),
+		Secret: "shh",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "shh", webhook.Secret)
+
+	_, err = models.NewWebhookFromRequest(models.WebhookRequest{
+		URL: models.WebURL(url.URL{Path: "/hook"}),
+	})
+	assert.Error(t, err)
+}
+
+func TestNewWebhookDelivery(t *testing.T) {
+	t.Parallel()
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	run := job.NewRun(initiator)
+	run.Status = models.RunStatusErrored
+	run.Result.ErrorMessage.SetValid("boom")
+
+	webhook := models.NewWebhook()
+	delivery, err := models.NewWebhookDelivery(webhook, models.WebhookEventRunErrored, run)
+	require.NoError(t, err)
+
+	assert.Equal(t, webhook.ID, delivery.WebhookID)
+	assert.Equal(t, run.ID, delivery.RunID)
+	assert.Equal(t, models.WebhookDeliveryStatusPending, delivery.Status)
+
+	var payload models.WebhookPayload
+	require.NoError(t, json.Unmarshal([]byte(delivery.Body), &payload))
+	assert.Equal(t, models.WebhookEventRunErrored, payload.Event)
+	assert.Equal(t, job.ID, payload.JobID)
+	assert.Equal(t, run.ID, payload.RunID)
+	assert.Equal(t, models.RunStatusErrored, payload.Status)
+	assert.Equal(t, "boom", payload.Error.String)
+}
+
+func TestNewWebhookAccountDelivery(t *testing.T) {
+	t.Parallel()
+
+	webhook := models.NewWebhook()
+	address := cltest.NewAddress()
+	delivery, err := models.NewWebhookAccountDelivery(webhook, models.WebhookAccountPayload{
+		Event:     models.WebhookEventAccountLowBalance,
+		Address:   address,
+		Balance:   assets.NewEth(1),
+		Threshold: assets.NewEth(2),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, webhook.ID, delivery.WebhookID)
+	assert.Equal(t, models.WebhookEventAccountLowBalance, delivery.Event)
+	assert.Equal(t, "", delivery.RunID)
+	assert.Equal(t, models.WebhookDeliveryStatusPending, delivery.Status)
+
+	var payload models.WebhookAccountPayload
+	require.NoError(t, json.Unmarshal([]byte(delivery.Body), &payload))
+	assert.Equal(t, address, payload.Address)
+	assert.Equal(t, assets.NewEth(1), payload.Balance)
+	assert.Equal(t, assets.NewEth(2), payload.Threshold)
+	assert.False(t, payload.Timestamp.IsZero())
+}
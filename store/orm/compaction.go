@@ -0,0 +1,164 @@
+package orm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/utils"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/multierr"
+)
+
+// Bolt never returns the pages freed by deleted records to the file system,
+// so the database file only grows. Compaction copies the live records into a
+// fresh file, which then replaces the original. The original can only be
+// replaced while nothing has it open, so compaction is requested while the
+// node runs and carried out the next time it starts, before the database is
+// opened. The original is held open with the same exclusive lock the node
+// takes while it is copied and replaced, so compaction waits for, or times
+// out on, any other process using the database.
+//
+// Compaction does not swap the file in while the node runs: every service
+// shares the ORM's open storm.DB, and nothing guards it being replaced under
+// them. A requested compaction only takes effect once the node is restarted.
+
+// compactionRequestPath is the marker file recording that the database at
+// path should be compacted on the next start.
+func compactionRequestPath(path string) string {
+	return path + ".compact"
+}
+
+// RequestCompaction schedules the database to be compacted the next time the
+// node starts.
+func (orm *ORM) RequestCompaction() error {
+	marker := compactionRequestPath(orm.GetBolt().Path())
+	now := []byte(time.Now().UTC().Format(time.RFC3339))
+	return ioutil.WriteFile(marker, now, 0600)
+}
+
+// CancelCompaction removes a pending compaction request.
+func (orm *ORM) CancelCompaction() error {
+	err := os.Remove(compactionRequestPath(orm.GetBolt().Path()))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// CompactionRequested returns true if the database will be compacted the
+// next time the node starts.
+func (orm *ORM) CompactionRequested() bool {
+	return utils.FileExists(compactionRequestPath(orm.GetBolt().Path()))
+}
+
+// DatabaseSize returns the size in bytes of the database file.
+func (orm *ORM) DatabaseSize() (int64, error) {
+	info, err := os.Stat(orm.GetBolt().Path())
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// VerifyIntegrity checks the consistency of the database's pages, returning
+// every problem found.
+func (orm *ORM) VerifyIntegrity() error {
+	return orm.GetBolt().View(func(tx *bolt.Tx) error {
+		var merr error
+		for err := range tx.Check() {
+			merr = multierr.Append(merr, err)
+		}
+		return merr
+	})
+}
+
+// CompactIfRequested compacts the database at path if that was requested,
+// and removes the request. It must be called before the database is opened,
+// and fails if the database's lock cannot be taken within the timeout.
+func CompactIfRequested(path string, timeout time.Duration) error {
+	marker := compactionRequestPath(path)
+	if !utils.FileExists(marker) {
+		return nil
+	}
+	if err := Compact(path, timeout); err != nil {
+		return err
+	}
+	return os.Remove(marker)
+}
+
+// Compact copies the records of the database at path into a new file, and
+// replaces the original with it once the copy has been written and synced.
+// The original's lock is held throughout, and it is left untouched if
+// anything goes wrong.
+func Compact(path string, timeout time.Duration) error {
+	before, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	src, err := bolt.Open(path, before.Mode(), &bolt.Options{Timeout: timeout})
+	if err != nil {
+		return fmt.Errorf("error locking database for compaction: %+v", err)
+	}
+	defer src.Close()
+
+	compacted := path + ".compacting"
+	if err := os.Remove(compacted); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := copyDatabase(src, compacted, before.Mode()); err != nil {
+		os.Remove(compacted)
+		return fmt.Errorf("error compacting database: %+v", err)
+	}
+
+	after, err := os.Stat(compacted)
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(compacted, path); err != nil {
+		return err
+	}
+	logger.Infow("Compacted database", "path", path, "before", before.Size(), "after", after.Size())
+	return nil
+}
+
+func copyDatabase(src *bolt.DB, dstPath string, mode os.FileMode) error {
+	dst, err := bolt.Open(dstPath, mode, nil)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	return src.View(func(stx *bolt.Tx) error {
+		return dst.Update(func(dtx *bolt.Tx) error {
+			return stx.ForEach(func(name []byte, b *bolt.Bucket) error {
+				nb, err := dtx.CreateBucket(name)
+				if err != nil {
+					return err
+				}
+				return copyBucket(b, nb)
+			})
+		})
+	})
+}
+
+// copyBucket copies the records and nested buckets of src into dst, along
+// with its sequence, which storm uses for auto incremented IDs.
+func copyBucket(src, dst *bolt.Bucket) error {
+	if err := dst.SetSequence(src.Sequence()); err != nil {
+		return err
+	}
+	return src.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return dst.Put(k, v)
+		}
+		nb, err := dst.CreateBucket(k)
+		if err != nil {
+			return err
+		}
+		return copyBucket(src.Bucket(k), nb)
+	})
+}
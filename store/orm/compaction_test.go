@@ -0,0 +1,88 @@
+package orm_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestORM_RequestCompaction(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	size, err := store.DatabaseSize()
+	require.NoError(t, err)
+	assert.True(t, size > 0)
+
+	assert.False(t, store.CompactionRequested())
+	require.NoError(t, store.RequestCompaction())
+	assert.True(t, store.CompactionRequested())
+	require.NoError(t, store.CancelCompaction())
+	assert.False(t, store.CompactionRequested())
+	assert.NoError(t, store.CancelCompaction())
+}
+
+func TestCompactIfRequested(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	for i := 0; i < 200; i++ {
+		run := job.NewRun(initr)
+		require.NoError(t, store.SaveJobRun(&run))
+		require.NoError(t, store.DeleteJobRun(&run))
+	}
+
+	path := store.GetBolt().Path()
+	require.NoError(t, store.ORM.Close())
+
+	require.NoError(t, orm.CompactIfRequested(path, time.Second))
+	unrequested, err := orm.NewORM(path, time.Second)
+	require.NoError(t, err)
+	before, err := unrequested.DatabaseSize()
+	require.NoError(t, err)
+	require.NoError(t, unrequested.RequestCompaction())
+	require.NoError(t, unrequested.Close())
+
+	require.NoError(t, orm.CompactIfRequested(path, time.Second))
+
+	compacted, err := orm.NewORM(path, time.Second)
+	require.NoError(t, err)
+	defer compacted.Close()
+	assert.False(t, compacted.CompactionRequested())
+	after, err := compacted.DatabaseSize()
+	require.NoError(t, err)
+	assert.True(t, after < before, "expected %d to be less than %d", after, before)
+
+	found, err := compacted.FindJob(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, found.ID)
+	require.Len(t, found.Initiators, 1)
+
+	runs, err := compacted.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+}
+
+func TestCompactIfRequested_Locked(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	path := store.GetBolt().Path()
+	require.NoError(t, store.RequestCompaction())
+
+	err := orm.CompactIfRequested(path, 100*time.Millisecond)
+	assert.Error(t, err, "expected compaction to wait for the open database's lock")
+	assert.True(t, store.CompactionRequested())
+
+	_, err = store.FindJob("bogus")
+	assert.Equal(t, orm.ErrorNotFound, err)
+}
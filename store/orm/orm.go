@@ -4,6 +4,7 @@ import (
 	"crypto/subtle"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"reflect"
 	"sort"
@@ -19,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/utils"
 	bolt "go.etcd.io/bbolt"
 	"go.uber.org/multierr"
+	null "gopkg.in/guregu/null.v3"
 )
 
 var (
@@ -28,6 +30,14 @@ var (
 	ErrorInvalidCallbackModel = errors.New("AllInBatches callback has incorrect model, must match bucket")
 	// ErrorNotFound is returned when finding a single value fails.
 	ErrorNotFound = storm.ErrNotFound
+	// ErrorBulkTaskCancelled is returned when saving the progress of a bulk task that has been cancelled.
+	ErrorBulkTaskCancelled = errors.New("bulk task has been cancelled")
+	// ErrorBulkTaskFinished is returned when cancelling a bulk task that is no longer in progress.
+	ErrorBulkTaskFinished = errors.New("bulk task has already finished")
+	// ErrorJobArchived is returned when archiving a job that is already archived.
+	ErrorJobArchived = errors.New("job has already been archived")
+	// ErrorJobVersionConflict is returned when updating a job from a version that is no longer its latest.
+	ErrorJobVersionConflict = errors.New("job has been updated since this version was read")
 )
 
 // ORM contains the database object used by Chainlink.
@@ -58,6 +68,17 @@ func (orm *ORM) GetBolt() *bolt.DB {
 	return orm.DB.Bolt
 }
 
+// WriteBackup writes a consistent snapshot of the database to w, through a
+// read-only transaction that does not block writers.
+func (orm *ORM) WriteBackup(w io.Writer) (int64, error) {
+	tx, err := orm.GetBolt().Begin(false)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	return tx.WriteTo(w)
+}
+
 // Where fetches multiple objects with "Find" in Storm.
 func (orm *ORM) Where(field string, value interface{}, instance interface{}) error {
 	err := orm.Find(field, value, instance)
@@ -104,6 +125,42 @@ func (orm *ORM) FindJob(id string) (models.JobSpec, error) {
 	return job, err
 }
 
+// FindActiveJobByName looks up the unarchived job with the given name.
+func (orm *ORM) FindActiveJobByName(name string) (models.JobSpec, error) {
+	var jobs []models.JobSpec
+	if err := orm.Where("Name", name, &jobs); err != nil {
+		return models.JobSpec{}, err
+	}
+	for _, j := range jobs {
+		if !j.Archived() {
+			return j, nil
+		}
+	}
+	return models.JobSpec{}, ErrorNotFound
+}
+
+// ArchiveJob marks the job with the given ID as archived and returns it.
+func (orm *ORM) ArchiveJob(id string) (models.JobSpec, error) {
+	var job models.JobSpec
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return job, fmt.Errorf("error starting transaction: %+v", err)
+	}
+	defer tx.Rollback()
+
+	if err := tx.One("ID", id, &job); err != nil {
+		return job, err
+	} else if job.Archived() {
+		return job, ErrorJobArchived
+	}
+
+	job.ArchivedAt = null.TimeFrom(time.Now())
+	if err := tx.Save(&job); err != nil {
+		return job, err
+	}
+	return job, tx.Commit()
+}
+
 // FindInitiator returns the single initiator defined by the passed ID.
 func (orm *ORM) FindInitiator(ID int) (models.Initiator, error) {
 	initr := models.Initiator{}
@@ -137,15 +194,88 @@ func (orm *ORM) FindServiceAgreement(id string) (models.ServiceAgreement, error)
 	return sa, orm.One("ID", id, &sa)
 }
 
+// FindServiceAgreementForJob looks up the ServiceAgreement whose job spec
+// has the passed ID.
+func (orm *ORM) FindServiceAgreementForJob(jobID string) (models.ServiceAgreement, error) {
+	var sa models.ServiceAgreement
+	return sa, orm.One("JobSpecID", jobID, &sa)
+}
+
+// ServiceAgreements returns a page of service agreements, newest first, and
+// the total count.
+func (orm *ORM) ServiceAgreements(offset, limit int) ([]models.ServiceAgreement, int, error) {
+	count, err := orm.Count(&models.ServiceAgreement{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var sas []models.ServiceAgreement
+	query := orm.Select().OrderBy("CreatedAt").Reverse().Skip(offset).Limit(limit)
+	err = query.Find(&sas)
+	if err == storm.ErrNotFound {
+		err = nil
+	}
+	return sas, count, err
+}
+
+// UninitiatedServiceAgreements returns the service agreements that have not
+// been seen being initiated on the Coordinator contract.
+func (orm *ORM) UninitiatedServiceAgreements() ([]models.ServiceAgreement, error) {
+	var sas []models.ServiceAgreement
+	if err := orm.All(&sas); err != nil {
+		return nil, err
+	}
+
+	uninitiated := []models.ServiceAgreement{}
+	for _, sa := range sas {
+		if !sa.Initiated() {
+			uninitiated = append(uninitiated, sa)
+		}
+	}
+	return uninitiated, nil
+}
+
+// UnexpiredServiceAgreementsSignedBy returns the service agreements signed
+// with the address that have not expired at the given time.
+func (orm *ORM) UnexpiredServiceAgreementsSignedBy(address common.Address, t time.Time) ([]models.ServiceAgreement, error) {
+	var sas []models.ServiceAgreement
+	if err := orm.All(&sas); err != nil {
+		return nil, err
+	}
+
+	signed := []models.ServiceAgreement{}
+	for _, sa := range sas {
+		if sa.SigningAddress == address && !sa.Expired(t) {
+			signed = append(signed, sa)
+		}
+	}
+	return signed, nil
+}
+
+// MarkServiceAgreementInitiated records the transaction in which the service
+// agreement was initiated on the Coordinator contract.
+func (orm *ORM) MarkServiceAgreementInitiated(id string, txHash common.Hash, blockNumber uint64) error {
+	sa, err := orm.FindServiceAgreement(id)
+	if err != nil {
+		return err
+	}
+	sa.InitiatedTxHash = txHash
+	sa.InitiatedBlockNumber = blockNumber
+	return orm.DB.Save(&sa)
+}
+
 // InitBucket initializes buckets and indexes before saving an object.
 func (orm *ORM) InitBucket(model interface{}) error {
 	return orm.Init(model)
 }
 
-// Jobs fetches all jobs.
+// Jobs fetches all jobs that have not been archived.
 func (orm *ORM) Jobs(cb func(models.JobSpec) bool) error {
 	var bucket []models.JobSpec
 	return orm.AllInBatches(&bucket, func(j models.JobSpec) bool {
+		if j.Archived() {
+			return true
+		}
 		return cb(j)
 	})
 }
@@ -197,6 +327,56 @@ func (orm *ORM) SaveJob(job *models.JobSpec) error {
 	return tx.Commit()
 }
 
+// UpdateJob saves the job as the version following its latest one,
+// replacing the initiators of the previous version, and records the new
+// version in the job's history.
+func (orm *ORM) UpdateJob(job *models.JobSpec) error {
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %+v", err)
+	}
+	defer tx.Rollback()
+
+	var current models.JobSpec
+	if err := tx.One("ID", job.ID, &current); err != nil {
+		return err
+	} else if current.Archived() {
+		return ErrorJobArchived
+	} else if job.Version != current.Version+1 {
+		return ErrorJobVersionConflict
+	}
+
+	for _, initr := range current.Initiators {
+		if !hasInitiator(job.Initiators, initr.ID) {
+			if err := tx.DeleteStruct(&initr); err != nil {
+				return fmt.Errorf("error deleting Job Initiators: %+v", err)
+			}
+			state := models.InitiatorState{InitiatorID: initr.ID}
+			if err := tx.DeleteStruct(&state); err != nil && err != storm.ErrNotFound {
+				return fmt.Errorf("error deleting Job Initiator state: %+v", err)
+			}
+		}
+	}
+	if err := saveJobSpec(job, tx); err != nil {
+		return err
+	}
+	if err := saveJobSpecVersion(*job, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func hasInitiator(initrs []models.Initiator, id int) bool {
+	for _, initr := range initrs {
+		if initr.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// saveJobSpec saves the job and its initiators. New jobs are saved as their
+// first version.
 func saveJobSpec(job *models.JobSpec, tx storm.Node) error {
 	for i := range job.Initiators {
 		job.Initiators[i].JobID = job.ID
@@ -204,15 +384,74 @@ func saveJobSpec(job *models.JobSpec, tx storm.Node) error {
 			return fmt.Errorf("error saving Job Initiators: %+v", err)
 		}
 	}
+	if job.Version == 0 {
+		job.Version = 1
+		if err := saveJobSpecVersion(*job, tx); err != nil {
+			return err
+		}
+	}
 	if err := tx.Save(job); err != nil {
 		return fmt.Errorf("error saving job: %+v", err)
 	}
+	return saveJobSpecTags(*job, tx)
+}
+
+// saveJobSpecTags replaces the job's entries in the tag index.
+func saveJobSpecTags(job models.JobSpec, tx storm.Node) error {
+	var old []models.JobSpecTag
+	if err := tx.Find("JobSpecID", job.ID, &old); err != nil && err != storm.ErrNotFound {
+		return fmt.Errorf("error loading job tags: %+v", err)
+	}
+	for i := range old {
+		if err := tx.DeleteStruct(&old[i]); err != nil {
+			return fmt.Errorf("error deleting job tags: %+v", err)
+		}
+	}
+	for _, tag := range models.NewJobSpecTags(job) {
+		if err := tx.Save(&tag); err != nil {
+			return fmt.Errorf("error saving job tags: %+v", err)
+		}
+	}
+	return nil
+}
+
+func saveJobSpecVersion(job models.JobSpec, tx storm.Node) error {
+	version := models.NewJobSpecVersion(job)
+	if err := tx.Save(&version); err != nil {
+		return fmt.Errorf("error saving job version: %+v", err)
+	}
 	return nil
 }
 
+// JobSpecVersions returns every saved version of a job, oldest first.
+func (orm *ORM) JobSpecVersions(jobID string) ([]models.JobSpecVersion, error) {
+	versions := []models.JobSpecVersion{}
+	err := orm.Select(q.Eq("JobID", jobID)).OrderBy("Version").Find(&versions)
+	if err == ErrorNotFound {
+		return versions, nil
+	}
+	return versions, err
+}
+
+// FindJobSpecVersion returns the given version of a job.
+func (orm *ORM) FindJobSpecVersion(jobID string, version uint32) (models.JobSpecVersion, error) {
+	var v models.JobSpecVersion
+	err := orm.One("ID", models.JobSpecVersionID(jobID, version), &v)
+	return v, err
+}
+
 // SaveServiceAgreement saves a service agreement and it's associations to the
 // database.
 func (orm *ORM) SaveServiceAgreement(sa *models.ServiceAgreement) error {
+	if sa.CreatedBlockNumber == 0 {
+		head, err := orm.LastHead()
+		if err != nil {
+			return fmt.Errorf("error finding creation block for service agreement: %+v", err)
+		} else if head != nil {
+			sa.CreatedBlockNumber = head.ToInt().Uint64()
+		}
+	}
+
 	tx, err := orm.Begin(true)
 	if err != nil {
 		return fmt.Errorf("error starting transaction: %+v", err)
@@ -264,6 +503,215 @@ func (orm *ORM) AnyJobWithType(taskTypeName string) (bool, error) {
 	return found, err
 }
 
+// SaveBulkDeleteRunTask creates or updates a BulkDeleteRunTask.
+func (orm *ORM) SaveBulkDeleteRunTask(task *models.BulkDeleteRunTask) error {
+	return orm.DB.Save(task)
+}
+
+// FindBulkDeleteRunTask looks up a BulkDeleteRunTask by its ID.
+func (orm *ORM) FindBulkDeleteRunTask(id string) (models.BulkDeleteRunTask, error) {
+	var task models.BulkDeleteRunTask
+	return task, orm.One("ID", id, &task)
+}
+
+// BulkDeleteRunTasksWithStatus returns the bulk delete tasks with the
+// passed status.
+func (orm *ORM) BulkDeleteRunTasksWithStatus(status models.BulkTaskStatus) ([]models.BulkDeleteRunTask, error) {
+	tasks := []models.BulkDeleteRunTask{}
+	err := orm.Select(q.Eq("Status", status)).Find(&tasks)
+	if err == storm.ErrNotFound {
+		return []models.BulkDeleteRunTask{}, nil
+	}
+	return tasks, err
+}
+
+// SaveBulkDeleteRunTaskProgress saves a BulkDeleteRunTask that is being
+// worked on, unless it has been cancelled in the meantime. In that case the
+// task's status is set to cancelled and ErrorBulkTaskCancelled is returned.
+func (orm *ORM) SaveBulkDeleteRunTaskProgress(task *models.BulkDeleteRunTask) error {
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %+v", err)
+	}
+	defer tx.Rollback()
+
+	var current models.BulkDeleteRunTask
+	if err := tx.One("ID", task.ID, &current); err != nil && err != storm.ErrNotFound {
+		return err
+	} else if current.Status == models.BulkTaskStatusCancelled {
+		task.Status = models.BulkTaskStatusCancelled
+		return ErrorBulkTaskCancelled
+	}
+
+	if err := tx.Save(task); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// CancelBulkDeleteRunTask marks the in progress BulkDeleteRunTask with the
+// given ID as cancelled, and returns it.
+func (orm *ORM) CancelBulkDeleteRunTask(id string) (models.BulkDeleteRunTask, error) {
+	var task models.BulkDeleteRunTask
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return task, fmt.Errorf("error starting transaction: %+v", err)
+	}
+	defer tx.Rollback()
+
+	if err := tx.One("ID", id, &task); err != nil {
+		return task, err
+	} else if task.Status != models.BulkTaskStatusInProgress {
+		return task, ErrorBulkTaskFinished
+	}
+
+	task.Status = models.BulkTaskStatusCancelled
+	if err := tx.Save(&task); err != nil {
+		return task, err
+	}
+	return task, tx.Commit()
+}
+
+// JobRunsMatching returns the runs a bulk delete with the query would remove.
+func (orm *ORM) JobRunsMatching(bulkQuery *models.BulkDeleteRunRequest) ([]models.JobRun, error) {
+	runs := []models.JobRun{}
+	err := orm.bulkDeleteRunsQuery(bulkQuery).Each(&models.JobRun{}, func(r interface{}) error {
+		run := r.(*models.JobRun)
+		if bulkQuery.Matches(*run) {
+			runs = append(runs, *run)
+		}
+		return nil
+	})
+	if err == storm.ErrNotFound {
+		return []models.JobRun{}, nil
+	}
+	return runs, err
+}
+
+// BulkDeleteRunCandidates calls fn with each run that has one of the query's
+// statuses and an ID after the passed one, in ID order, in a single pass over
+// the runs. Callers check the runs against the rest of the query with
+// Matches.
+func (orm *ORM) BulkDeleteRunCandidates(
+	bulkQuery *models.BulkDeleteRunRequest,
+	after string,
+	fn func(*models.JobRun) error,
+) error {
+	err := orm.bulkDeleteRunsQuery(bulkQuery).Each(&models.JobRun{}, func(r interface{}) error {
+		run := r.(*models.JobRun)
+		if run.ID <= after {
+			return nil
+		}
+		return fn(run)
+	})
+	if err == storm.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+func (orm *ORM) bulkDeleteRunsQuery(bulkQuery *models.BulkDeleteRunRequest) storm.Query {
+	return orm.Select(q.In("Status", bulkQuery.Status))
+}
+
+// DeleteJobRun removes the run.
+func (orm *ORM) DeleteJobRun(run *models.JobRun) error {
+	logger.Debugw("Deleting run", "run_id", run.ID, "status", run.Status, "updated_at", run.UpdatedAt)
+	if err := orm.DeleteStruct(run); err != nil {
+		return fmt.Errorf("error deleting run %s: %+v", run.ID, err)
+	}
+	return nil
+}
+
+// jobRunDeleteBatchSize is the number of runs DeleteJobRuns removes in each
+// transaction.
+const jobRunDeleteBatchSize = 100
+
+// DeleteJobRuns removes the runs with the passed IDs, a batch at a time.
+// Runs that have already been deleted are skipped.
+func (orm *ORM) DeleteJobRuns(ids []string) error {
+	for start := 0; start < len(ids); start += jobRunDeleteBatchSize {
+		end := start + jobRunDeleteBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		if err := orm.deleteJobRunBatch(ids[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (orm *ORM) deleteJobRunBatch(ids []string) error {
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %+v", err)
+	}
+	defer tx.Rollback()
+
+	for _, id := range ids {
+		logger.Debugw("Deleting run", "run_id", id)
+		if err := tx.DeleteStruct(&models.JobRun{ID: id}); err != nil && err != storm.ErrNotFound {
+			return fmt.Errorf("error deleting run %s: %+v", id, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// JobRunIDsBeyondCount returns the IDs of the runs of each job and status
+// that are older than its count most recently updated runs, least recently
+// updated first. Runs are looked up a job at a time through the JobID index.
+func (orm *ORM) JobRunIDsBeyondCount(statuses []models.RunStatus, count uint64) ([]string, error) {
+	wanted := map[models.RunStatus]bool{}
+	for _, status := range statuses {
+		wanted[status] = true
+	}
+
+	excess := []models.JobRun{}
+	var findErr error
+	var bucket []models.JobSpec
+	err := orm.AllInBatches(&bucket, func(j models.JobSpec) bool {
+		runs := []models.JobRun{}
+		if err := orm.Find("JobID", j.ID, &runs); err != nil && err != storm.ErrNotFound {
+			findErr = err
+			return false
+		}
+
+		byStatus := map[models.RunStatus][]models.JobRun{}
+		for _, run := range runs {
+			if wanted[run.Status] {
+				byStatus[run.Status] = append(byStatus[run.Status], run)
+			}
+		}
+		for _, runs := range byStatus {
+			if uint64(len(runs)) <= count {
+				continue
+			}
+			sort.Slice(runs, func(i, k int) bool {
+				return runs[i].UpdatedAt.After(runs[k].UpdatedAt)
+			})
+			for _, run := range runs[count:] {
+				excess = append(excess, models.JobRun{ID: run.ID, UpdatedAt: run.UpdatedAt})
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	} else if findErr != nil {
+		return nil, findErr
+	}
+
+	sort.Slice(excess, func(i, j int) bool {
+		return excess[i].UpdatedAt.Before(excess[j].UpdatedAt)
+	})
+	ids := make([]string, len(excess))
+	for i, run := range excess {
+		ids[i] = run.ID
+	}
+	return ids, nil
+}
+
 // CreateTx saves the properties of an Ethereum transaction to the database.
 func (orm *ORM) CreateTx(
 	from common.Address,
@@ -595,15 +1043,127 @@ func stormOrder(st SortType) func(*index.Options) {
 	return so
 }
 
-// JobsSorted returns many JobSpecs sorted by CreatedAt from the store adhering
-// to the passed parameters.
-func (orm *ORM) JobsSorted(order SortType, offset int, limit int) ([]models.JobSpec, error) {
-	stormOffset := storm.Skip(offset)
-	stormLimit := storm.Limit(limit)
+// JobSpecFilter restricts the jobs returned by JobsSorted. Fields left at
+// their zero value match every job.
+type JobSpecFilter struct {
+	Name string
+	// Tags must all be present on a job for it to match.
+	Tags []string
+}
 
-	var jobs []models.JobSpec
-	err := orm.AllByIndex("CreatedAt", &jobs, stormOrder(order), stormOffset, stormLimit)
-	return jobs, err
+func (f JobSpecFilter) empty() bool {
+	return f.Name == "" && len(f.Tags) == 0
+}
+
+// JobsSorted returns many JobSpecs matching the filter, sorted by CreatedAt
+// from the store adhering to the passed parameters, along with the total
+// number of matching jobs. Filtered jobs are looked up through the Name
+// index, or the tag index, and only the requested page of jobs is loaded.
+func (orm *ORM) JobsSorted(filter JobSpecFilter, order SortType, offset int, limit int) ([]models.JobSpec, int, error) {
+	if filter.empty() {
+		count, err := orm.Count(&models.JobSpec{})
+		if err != nil {
+			return nil, 0, err
+		}
+		var jobs []models.JobSpec
+		err = orm.AllByIndex("CreatedAt", &jobs, stormOrder(order), storm.Skip(offset), storm.Limit(limit))
+		return jobs, count, err
+	}
+
+	if filter.Name != "" {
+		return orm.jobsNamed(filter, order, offset, limit)
+	}
+
+	tags, err := orm.jobSpecTagsMatching(filter.Tags)
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		if order == Descending {
+			return tags[i].CreatedAt.After(tags[j].CreatedAt.Time)
+		}
+		return tags[i].CreatedAt.Before(tags[j].CreatedAt.Time)
+	})
+
+	count := len(tags)
+	lo, hi := paginate(count, offset, limit)
+	tags = tags[lo:hi]
+	jobs := make([]models.JobSpec, len(tags))
+	for i, tag := range tags {
+		if err := orm.One("ID", tag.JobSpecID, &jobs[i]); err != nil {
+			return nil, 0, err
+		}
+	}
+	return jobs, count, nil
+}
+
+// jobsNamed returns the page of jobs with the filter's name and tags.
+func (orm *ORM) jobsNamed(filter JobSpecFilter, order SortType, offset int, limit int) ([]models.JobSpec, int, error) {
+	named := []models.JobSpec{}
+	if err := orm.Find("Name", filter.Name, &named); err != nil && err != storm.ErrNotFound {
+		return nil, 0, err
+	}
+
+	jobs := []models.JobSpec{}
+	for _, job := range named {
+		if job.HasTags(filter.Tags...) {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		if order == Descending {
+			return jobs[i].CreatedAt.After(jobs[j].CreatedAt.Time)
+		}
+		return jobs[i].CreatedAt.Before(jobs[j].CreatedAt.Time)
+	})
+
+	count := len(jobs)
+	lo, hi := paginate(count, offset, limit)
+	return jobs[lo:hi], count, nil
+}
+
+// jobSpecTagsMatching returns the index entries of the first tag for the
+// jobs that have every one of the tags.
+func (orm *ORM) jobSpecTagsMatching(tags []string) ([]models.JobSpecTag, error) {
+	var matching []models.JobSpecTag
+	for i, tag := range tags {
+		var tagged []models.JobSpecTag
+		if err := orm.Find("Tag", tag, &tagged); err == storm.ErrNotFound {
+			return []models.JobSpecTag{}, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			matching = tagged
+			continue
+		}
+
+		has := map[string]bool{}
+		for _, t := range tagged {
+			has[t.JobSpecID] = true
+		}
+		remaining := []models.JobSpecTag{}
+		for _, t := range matching {
+			if has[t.JobSpecID] {
+				remaining = append(remaining, t)
+			}
+		}
+		matching = remaining
+	}
+	return matching, nil
+}
+
+// paginate returns the bounds of the page at offset, of up to limit items,
+// in a collection of count items.
+func paginate(count, offset, limit int) (lo, hi int) {
+	if offset > count {
+		offset = count
+	}
+	end := offset + limit
+	if limit < 0 || end > count {
+		end = count
+	}
+	return offset, end
 }
 
 // TxFrom returns all transactions from a particular address.
@@ -681,6 +1241,226 @@ func (orm *ORM) JobRunsSortedFor(id string, order SortType, offset int, limit in
 	return runs, count, err
 }
 
+// JobRunFilter restricts the job runs returned by FilteredJobRuns. Fields
+// left at their zero value match every run.
+type JobRunFilter struct {
+	JobID           string
+	Statuses        []models.RunStatus
+	InitiatorTypes  []string
+	TaskTypes       []string
+	Requester       *common.Address
+	CreatedAfter    time.Time
+	CreatedBefore   time.Time
+	CompletedAfter  time.Time
+	CompletedBefore time.Time
+}
+
+func (f JobRunFilter) matchers() []q.Matcher {
+	matchers := []q.Matcher{}
+	if f.JobID != "" {
+		matchers = append(matchers, q.Eq("JobID", f.JobID))
+	}
+	if len(f.Statuses) > 0 {
+		matchers = append(matchers, q.In("Status", f.Statuses))
+	}
+	if len(f.InitiatorTypes) > 0 {
+		matchers = append(matchers, q.NewFieldMatcher("Initiator", initiatorTypeMatcher(f.InitiatorTypes)))
+	}
+	if len(f.TaskTypes) > 0 {
+		matchers = append(matchers, q.NewFieldMatcher("TaskRuns", taskTypeMatcher(f.TaskTypes)))
+	}
+	if f.Requester != nil {
+		matchers = append(matchers, q.NewFieldMatcher("Requester", requesterMatcher(*f.Requester)))
+	}
+	if !f.CreatedAfter.IsZero() {
+		matchers = append(matchers, q.Gte("CreatedAt", f.CreatedAfter))
+	}
+	if !f.CreatedBefore.IsZero() {
+		matchers = append(matchers, q.Lt("CreatedAt", f.CreatedBefore))
+	}
+	if !f.CompletedAfter.IsZero() || !f.CompletedBefore.IsZero() {
+		matchers = append(matchers, q.NewFieldMatcher("CompletedAt", completedMatcher{
+			after:  f.CompletedAfter,
+			before: f.CompletedBefore,
+		}))
+	}
+	return matchers
+}
+
+type initiatorTypeMatcher []string
+
+func (types initiatorTypeMatcher) MatchField(v interface{}) (bool, error) {
+	initr, ok := v.(models.Initiator)
+	if !ok {
+		return false, fmt.Errorf("unexpected initiator field type %T", v)
+	}
+	return containsString(types, initr.Type), nil
+}
+
+type taskTypeMatcher []string
+
+func (types taskTypeMatcher) MatchField(v interface{}) (bool, error) {
+	taskRuns, ok := v.([]models.TaskRun)
+	if !ok {
+		return false, fmt.Errorf("unexpected task runs field type %T", v)
+	}
+	for _, tr := range taskRuns {
+		if containsString(types, tr.Task.Type.String()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type requesterMatcher common.Address
+
+func (address requesterMatcher) MatchField(v interface{}) (bool, error) {
+	requester, ok := v.(*common.Address)
+	if !ok {
+		return false, fmt.Errorf("unexpected requester field type %T", v)
+	}
+	return requester != nil && *requester == common.Address(address), nil
+}
+
+type completedMatcher struct {
+	after, before time.Time
+}
+
+func (m completedMatcher) MatchField(v interface{}) (bool, error) {
+	completedAt, ok := v.(null.Time)
+	if !ok {
+		return false, fmt.Errorf("unexpected completed at field type %T", v)
+	}
+	if !completedAt.Valid {
+		return false, nil
+	}
+	if !m.after.IsZero() && completedAt.Time.Before(m.after) {
+		return false, nil
+	}
+	if !m.before.IsZero() && !completedAt.Time.Before(m.before) {
+		return false, nil
+	}
+	return true, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// FilteredJobRuns returns the job runs matching the filter, ordered by the
+// passed field and limited by the passed params, along with the total number
+// of matching runs. When the filter names a job, a requester or statuses,
+// runs are looked up through that field's index and only those are matched
+// against the rest of the filter.
+func (orm *ORM) FilteredJobRuns(
+	filter JobRunFilter,
+	sortField string,
+	order SortType,
+	offset int,
+	limit int,
+) ([]models.JobRun, int, error) {
+	matchers := filter.matchers()
+	field, values := filter.indexed()
+	if field == "" {
+		return orm.selectJobRuns(matchers, sortField, order, offset, limit)
+	}
+
+	runs := []models.JobRun{}
+	matcher := q.And(matchers...)
+	for _, value := range values {
+		var candidates []models.JobRun
+		err := orm.Find(field, value, &candidates) // Use Find to leverage db index
+		if err == storm.ErrNotFound {
+			continue
+		} else if err != nil {
+			return nil, 0, err
+		}
+		for _, run := range candidates {
+			if ok, err := matcher.Match(&run); err != nil {
+				return nil, 0, err
+			} else if ok {
+				runs = append(runs, run)
+			}
+		}
+	}
+
+	sortJobRuns(runs, sortField, order)
+	count := len(runs)
+	if offset >= count {
+		return []models.JobRun{}, count, nil
+	}
+	runs = runs[offset:]
+	if limit > 0 && limit < len(runs) {
+		runs = runs[:limit]
+	}
+	return runs, count, nil
+}
+
+// indexed returns the indexed field, and the values of it, that narrow down
+// the runs matching the filter the most, or an empty field when the filter
+// has none.
+func (f JobRunFilter) indexed() (string, []interface{}) {
+	if f.JobID != "" {
+		return "JobID", []interface{}{f.JobID}
+	}
+	if f.Requester != nil {
+		return "Requester", []interface{}{f.Requester}
+	}
+	if len(f.Statuses) > 0 {
+		values := []interface{}{}
+		for _, status := range f.Statuses {
+			values = append(values, status)
+		}
+		return "Status", values
+	}
+	return "", nil
+}
+
+func (orm *ORM) selectJobRuns(
+	matchers []q.Matcher,
+	sortField string,
+	order SortType,
+	offset int,
+	limit int,
+) ([]models.JobRun, int, error) {
+	count, err := orm.Select(matchers...).Count(&models.JobRun{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := orm.Select(matchers...).OrderBy(sortField).Limit(limit).Skip(offset)
+	if order == Descending {
+		query = query.Reverse()
+	}
+
+	runs := []models.JobRun{}
+	err = query.Find(&runs)
+	if err == storm.ErrNotFound {
+		err = nil
+	}
+	return runs, count, err
+}
+
+func sortJobRuns(runs []models.JobRun, field string, order SortType) {
+	at := func(run models.JobRun) time.Time {
+		if field == "UpdatedAt" {
+			return run.UpdatedAt
+		}
+		return run.CreatedAt
+	}
+	sort.SliceStable(runs, func(i, j int) bool {
+		if order == Descending {
+			return at(runs[i]).After(at(runs[j]))
+		}
+		return at(runs[i]).Before(at(runs[j]))
+	})
+}
+
 // BridgeTypes returns bridge types ordered by name filtered limited by the
 // passed params.
 func (orm *ORM) BridgeTypes(offset int, limit int) ([]models.BridgeType, int, error) {
@@ -719,6 +1499,22 @@ func (orm *ORM) SaveInitiator(initr *models.Initiator) error {
 	return orm.DB.Save(initr)
 }
 
+// FindInitiatorState returns what was recorded about the initiator as it
+// ran, which is empty if nothing was.
+func (orm *ORM) FindInitiatorState(initiatorID int) (models.InitiatorState, error) {
+	state := models.InitiatorState{InitiatorID: initiatorID}
+	err := orm.One("InitiatorID", initiatorID, &state)
+	if err == ErrorNotFound {
+		return state, nil
+	}
+	return state, err
+}
+
+// SaveInitiatorState saves what was recorded about an initiator as it ran.
+func (orm *ORM) SaveInitiatorState(state *models.InitiatorState) error {
+	return orm.DB.Save(state)
+}
+
 // SaveHead saves the indexable block number related to head tracker.
 func (orm *ORM) SaveHead(n *models.IndexableBlockNumber) error {
 	return orm.DB.Save(n)
@@ -743,6 +1539,46 @@ func (orm *ORM) LastHead() (*models.IndexableBlockNumber, error) {
 	return &numbers[0], err
 }
 
+const (
+	settingsBucket                = "Settings"
+	signingAddressKey             = "SigningAddress"
+	serviceAgreementsCheckedBlock = "ServiceAgreementsCheckedBlock"
+)
+
+// SigningAddress returns the address the service agreement signing key was
+// last rotated to, or nil if it was never rotated.
+func (orm *ORM) SigningAddress() (*common.Address, error) {
+	var address common.Address
+	err := orm.Get(settingsBucket, signingAddressKey, &address)
+	if err == storm.ErrNotFound {
+		return nil, nil
+	}
+	return &address, err
+}
+
+// SetSigningAddress records the address of the service agreement signing key,
+// taking precedence over the configured signing account.
+func (orm *ORM) SetSigningAddress(address common.Address) error {
+	return orm.Set(settingsBucket, signingAddressKey, address)
+}
+
+// ServiceAgreementsCheckedBlock returns the last block searched for service
+// agreement initiations, or nil if none has been searched.
+func (orm *ORM) ServiceAgreementsCheckedBlock() (*uint64, error) {
+	var number uint64
+	err := orm.Get(settingsBucket, serviceAgreementsCheckedBlock, &number)
+	if err == storm.ErrNotFound {
+		return nil, nil
+	}
+	return &number, err
+}
+
+// SetServiceAgreementsCheckedBlock records the last block searched for
+// service agreement initiations.
+func (orm *ORM) SetServiceAgreementsCheckedBlock(number uint64) error {
+	return orm.Set(settingsBucket, serviceAgreementsCheckedBlock, number)
+}
+
 // DeleteStaleSessions deletes all sessions before the passed time.
 func (orm *ORM) DeleteStaleSessions(before time.Time) error {
 	var sessions []models.Session
@@ -758,3 +1594,145 @@ func (orm *ORM) DeleteStaleSessions(before time.Time) error {
 	}
 	return merr
 }
+
+// SaveWebhook saves the webhook.
+func (orm *ORM) SaveWebhook(webhook *models.Webhook) error {
+	return orm.DB.Save(webhook)
+}
+
+// FindWebhook looks up a Webhook by its ID.
+func (orm *ORM) FindWebhook(id string) (models.Webhook, error) {
+	var webhook models.Webhook
+	return webhook, orm.One("ID", id, &webhook)
+}
+
+// Webhooks returns webhooks ordered by creation time and limited by the
+// passed params.
+func (orm *ORM) Webhooks(offset, limit int) ([]models.Webhook, int, error) {
+	count, err := orm.Count(&models.Webhook{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var webhooks []models.Webhook
+	err = orm.AllByIndex("CreatedAt", &webhooks, storm.Skip(offset), storm.Limit(limit))
+	return webhooks, count, err
+}
+
+// WebhooksFor returns every webhook subscribed to the passed event.
+func (orm *ORM) WebhooksFor(event models.WebhookEvent) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+	if err := orm.All(&webhooks); err != nil {
+		return nil, err
+	}
+
+	subscribed := []models.Webhook{}
+	for _, wh := range webhooks {
+		if wh.Subscribes(event) {
+			subscribed = append(subscribed, wh)
+		}
+	}
+	return subscribed, nil
+}
+
+// DeleteWebhook removes a webhook and its delivery history.
+func (orm *ORM) DeleteWebhook(webhook *models.Webhook) error {
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %+v", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.Select(q.Eq("WebhookID", webhook.ID)).Delete(&models.WebhookDelivery{})
+	if err != nil && err != storm.ErrNotFound {
+		return err
+	}
+	if err := tx.DeleteStruct(webhook); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SaveWebhookDelivery saves the webhook delivery.
+func (orm *ORM) SaveWebhookDelivery(delivery *models.WebhookDelivery) error {
+	return orm.DB.Save(delivery)
+}
+
+// WebhookDeliveryExists returns true if the event has already been queued for
+// delivery to the webhook for the given run.
+func (orm *ORM) WebhookDeliveryExists(webhookID, runID string, event models.WebhookEvent) (bool, error) {
+	query := orm.Select(
+		q.Eq("WebhookID", webhookID),
+		q.Eq("RunID", runID),
+		q.Eq("Event", event),
+	)
+	count, err := query.Count(&models.WebhookDelivery{})
+	return count > 0, err
+}
+
+// WebhookDeliveriesFor returns the delivery history for a webhook, most
+// recent first, limited by the passed params.
+func (orm *ORM) WebhookDeliveriesFor(webhookID string, offset, limit int) ([]models.WebhookDelivery, int, error) {
+	count, err := orm.Select(q.Eq("WebhookID", webhookID)).Count(&models.WebhookDelivery{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var deliveries []models.WebhookDelivery
+	query := orm.Select(q.Eq("WebhookID", webhookID)).OrderBy("CreatedAt").Reverse().Skip(offset).Limit(limit)
+	err = query.Find(&deliveries)
+	if err == storm.ErrNotFound {
+		err = nil
+	}
+	return deliveries, count, err
+}
+
+// PendingWebhookDeliveries returns the pending deliveries due to be attempted
+// at or before the passed time.
+func (orm *ORM) PendingWebhookDeliveries(before time.Time) ([]models.WebhookDelivery, error) {
+	deliveries := []models.WebhookDelivery{}
+	query := orm.Select(
+		q.Eq("Status", models.WebhookDeliveryStatusPending),
+		q.Lte("NextAttemptAt", before),
+	).OrderBy("CreatedAt")
+	err := query.Find(&deliveries)
+	if err == storm.ErrNotFound {
+		return []models.WebhookDelivery{}, nil
+	}
+	return deliveries, err
+}
+
+// FindDeviationSubmission returns the last value the job submitted past its
+// "deviation" task.
+func (orm *ORM) FindDeviationSubmission(jobID string) (models.DeviationSubmission, error) {
+	var ds models.DeviationSubmission
+	return ds, orm.One("JobID", jobID, &ds)
+}
+
+// SaveDeviationSubmission saves the last value a job submitted past its
+// "deviation" task, replacing the one before it.
+func (orm *ORM) SaveDeviationSubmission(ds *models.DeviationSubmission) error {
+	return orm.DB.Save(ds)
+}
+
+// SaveLinkSweep saves the record of a LINK sweep.
+func (orm *ORM) SaveLinkSweep(sweep *models.LinkSweep) error {
+	return orm.DB.Save(sweep)
+}
+
+// LinkSweeps returns the history of LINK sweeps, most recent first, limited
+// by the passed params.
+func (orm *ORM) LinkSweeps(offset, limit int) ([]models.LinkSweep, int, error) {
+	count, err := orm.Count(&models.LinkSweep{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var sweeps []models.LinkSweep
+	query := orm.Select().OrderBy("CreatedAt").Reverse().Skip(offset).Limit(limit)
+	err = query.Find(&sweeps)
+	if err == storm.ErrNotFound {
+		err = nil
+	}
+	return sweeps, count, err
+}
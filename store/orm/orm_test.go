@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v3"
 )
 
 func TestWhereNotFound(t *testing.T) {
@@ -82,10 +83,85 @@ func TestJobRunsFor(t *testing.T) {
 	assert.Equal(t, []string{jr2.ID, jr1.ID, jr3.ID}, actual)
 }
 
+func TestORM_FilteredJobRuns(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	requester := cltest.NewAddress()
+	webJob, webInitr := cltest.NewJobWithWebInitiator()
+	logJob, logInitr := cltest.NewJobWithRunLogInitiator()
+	logJob.Tasks = []models.TaskSpec{cltest.NewTask("ethtx")}
+
+	completed := webJob.NewRun(webInitr)
+	completed.Status = models.RunStatusCompleted
+	completed.CreatedAt = cltest.ParseISO8601("2018-01-01T00:00:00Z")
+	completed.CompletedAt = null.TimeFrom(cltest.ParseISO8601("2018-01-01T01:00:00Z"))
+	require.NoError(t, store.SaveJobRun(&completed))
+
+	errored := webJob.NewRun(webInitr)
+	errored.Status = models.RunStatusErrored
+	errored.CreatedAt = cltest.ParseISO8601("2018-01-02T00:00:00Z")
+	require.NoError(t, store.SaveJobRun(&errored))
+
+	requested := logJob.NewRun(logInitr)
+	requested.Status = models.RunStatusPendingConfirmations
+	requested.CreatedAt = cltest.ParseISO8601("2018-01-03T00:00:00Z")
+	requested.Requester = &requester
+	require.NoError(t, store.SaveJobRun(&requested))
+
+	tests := []struct {
+		name   string
+		filter orm.JobRunFilter
+		want   []string
+	}{
+		{"everything", orm.JobRunFilter{}, []string{completed.ID, errored.ID, requested.ID}},
+		{"job", orm.JobRunFilter{JobID: webJob.ID}, []string{completed.ID, errored.ID}},
+		{"job and status", orm.JobRunFilter{JobID: webJob.ID, Statuses: []models.RunStatus{models.RunStatusErrored}}, []string{errored.ID}},
+		{"requester and status", orm.JobRunFilter{Requester: &requester, Statuses: []models.RunStatus{models.RunStatusCompleted}}, []string{}},
+		{"statuses", orm.JobRunFilter{Statuses: []models.RunStatus{models.RunStatusErrored, models.RunStatusPendingConfirmations}}, []string{errored.ID, requested.ID}},
+		{"initiator type", orm.JobRunFilter{InitiatorTypes: []string{models.InitiatorRunLog}}, []string{requested.ID}},
+		{"task type", orm.JobRunFilter{TaskTypes: []string{"ethtx"}}, []string{requested.ID}},
+		{"requester", orm.JobRunFilter{Requester: &requester}, []string{requested.ID}},
+		{"created after", orm.JobRunFilter{CreatedAfter: cltest.ParseISO8601("2018-01-02T00:00:00Z")}, []string{errored.ID, requested.ID}},
+		{"created before", orm.JobRunFilter{CreatedBefore: cltest.ParseISO8601("2018-01-02T00:00:00Z")}, []string{completed.ID}},
+		{"completed before", orm.JobRunFilter{CompletedBefore: cltest.ParseISO8601("2018-01-02T00:00:00Z")}, []string{completed.ID}},
+		{"completed after", orm.JobRunFilter{CompletedAfter: cltest.ParseISO8601("2018-01-02T00:00:00Z")}, []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runs, count, err := store.FilteredJobRuns(test.filter, "CreatedAt", orm.Ascending, 0, 10)
+			require.NoError(t, err)
+			assert.Equal(t, len(test.want), count)
+
+			ids := []string{}
+			for _, run := range runs {
+				ids = append(ids, run.ID)
+			}
+			assert.Equal(t, test.want, ids)
+		})
+	}
+
+	runs, count, err := store.FilteredJobRuns(orm.JobRunFilter{}, "CreatedAt", orm.Descending, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, runs, 1)
+	assert.Equal(t, requested.ID, runs[0].ID)
+
+	runs, count, err = store.FilteredJobRuns(orm.JobRunFilter{JobID: webJob.ID}, "CreatedAt", orm.Descending, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, runs, 1)
+	assert.Equal(t, completed.ID, runs[0].ID)
+}
+
 func TestORM_SaveServiceAgreement(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore()
 	defer cleanup()
+	require.NoError(t, store.SaveHead(models.NewIndexableBlockNumber(big.NewInt(42), cltest.NewHash())))
 
 	tests := []struct {
 		name  string
@@ -105,12 +181,72 @@ func TestORM_SaveServiceAgreement(t *testing.T) {
 
 			sa, err = store.FindServiceAgreement(sa.ID)
 			assert.NoError(t, err)
+			assert.Equal(t, cltest.MockSigner{}.Address(), sa.SigningAddress)
+			assert.Equal(t, uint64(42), sa.CreatedBlockNumber)
 			_, err = store.FindJob(sa.JobSpecID)
 			assert.NoError(t, err)
+
+			forJob, err := store.FindServiceAgreementForJob(sa.JobSpecID)
+			assert.NoError(t, err)
+			assert.Equal(t, sa.ID, forJob.ID)
 		})
 	}
 }
 
+func TestORM_ServiceAgreements(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	older, err := cltest.ServiceAgreementFromString(`{"initiators":[{"type":"web"}],"tasks":[{"type":"NoOp"}],"payment":"1"}`)
+	require.NoError(t, err)
+	older.CreatedAt = models.Time{Time: time.Now().Add(-time.Hour)}
+	require.NoError(t, store.SaveServiceAgreement(&older))
+	newer, err := cltest.ServiceAgreementFromString(`{"initiators":[{"type":"web"}],"tasks":[{"type":"NoOp"}],"payment":"2"}`)
+	require.NoError(t, err)
+	require.NoError(t, store.SaveServiceAgreement(&newer))
+
+	sas, count, err := store.ServiceAgreements(0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, sas, 1)
+	assert.Equal(t, newer.ID, sas[0].ID)
+
+	sas, _, err = store.ServiceAgreements(1, 1)
+	require.NoError(t, err)
+	require.Len(t, sas, 1)
+	assert.Equal(t, older.ID, sas[0].ID)
+
+	hash := cltest.NewHash()
+	require.NoError(t, store.MarkServiceAgreementInitiated(older.ID, hash, 42))
+	initiated, err := store.FindServiceAgreement(older.ID)
+	require.NoError(t, err)
+	assert.Equal(t, hash, initiated.InitiatedTxHash)
+	assert.Equal(t, uint64(42), initiated.InitiatedBlockNumber)
+
+	uninitiated, err := store.UninitiatedServiceAgreements()
+	require.NoError(t, err)
+	require.Len(t, uninitiated, 1)
+	assert.Equal(t, newer.ID, uninitiated[0].ID)
+}
+
+func TestORM_SigningAddress(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	address, err := store.SigningAddress()
+	require.NoError(t, err)
+	assert.Nil(t, address)
+
+	expected := cltest.NewAddress()
+	require.NoError(t, store.SetSigningAddress(expected))
+	address, err = store.SigningAddress()
+	require.NoError(t, err)
+	require.NotNil(t, address)
+	assert.Equal(t, expected, *address)
+}
+
 func TestJobRunsWithStatus(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore()
@@ -620,3 +756,311 @@ func TestORM_AllInBatches_IncorrectCallback(t *testing.T) {
 		})
 	}
 }
+
+func TestORM_BulkDeleteRunTasks(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	pending, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{UpdatedBefore: time.Now()})
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBulkDeleteRunTask(pending))
+	completed, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{UpdatedBefore: time.Now()})
+	require.NoError(t, err)
+	completed.Status = models.BulkTaskStatusCompleted
+	require.NoError(t, store.SaveBulkDeleteRunTask(completed))
+
+	found, err := store.FindBulkDeleteRunTask(completed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkTaskStatusCompleted, found.Status)
+	_, err = store.FindBulkDeleteRunTask("bogus")
+	assert.Equal(t, orm.ErrorNotFound, err)
+
+	tasks, err := store.BulkDeleteRunTasksWithStatus(models.BulkTaskStatusInProgress)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, pending.ID, tasks[0].ID)
+
+	tasks, err = store.BulkDeleteRunTasksWithStatus(models.BulkTaskStatusErrored)
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+}
+
+func TestORM_JobRunIDsBeyondCount_DeleteJobRuns(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	now := time.Now()
+	saveRun := func(status models.RunStatus, updatedAt time.Time) models.JobRun {
+		run := job.NewRun(initr)
+		run.Status = status
+		run.UpdatedAt = updatedAt
+		require.NoError(t, store.DB.Save(&run))
+		return run
+	}
+	oldest := saveRun(models.RunStatusCompleted, now.Add(-3*time.Hour))
+	older := saveRun(models.RunStatusCompleted, now.Add(-2*time.Hour))
+	kept := saveRun(models.RunStatusCompleted, now.Add(-time.Hour))
+	errored := saveRun(models.RunStatusErrored, now.Add(-3*time.Hour))
+
+	ids, err := store.JobRunIDsBeyondCount([]models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{oldest.ID, older.ID}, ids)
+
+	require.NoError(t, store.DeleteJobRuns(ids))
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	assert.Len(t, runs, 2)
+	for _, run := range runs {
+		assert.Contains(t, []string{kept.ID, errored.ID}, run.ID)
+	}
+	count, err := store.Count(&models.JobRun{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestORM_SaveBulkDeleteRunTaskProgress(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	task, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{UpdatedBefore: time.Now()})
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBulkDeleteRunTask(task))
+
+	task.Scanned, task.Deleted = 3, 2
+	require.NoError(t, store.SaveBulkDeleteRunTaskProgress(task))
+	found, err := store.FindBulkDeleteRunTask(task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), found.Scanned)
+	assert.Equal(t, uint64(2), found.Deleted)
+
+	_, err = store.CancelBulkDeleteRunTask(task.ID)
+	require.NoError(t, err)
+
+	task.Scanned, task.Deleted = 6, 4
+	assert.Equal(t, orm.ErrorBulkTaskCancelled, store.SaveBulkDeleteRunTaskProgress(task))
+	assert.Equal(t, models.BulkTaskStatusCancelled, task.Status)
+	found, err = store.FindBulkDeleteRunTask(task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkTaskStatusCancelled, found.Status)
+	assert.Equal(t, uint64(2), found.Deleted)
+}
+
+func TestORM_CancelBulkDeleteRunTask(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	pending, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{UpdatedBefore: time.Now()})
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBulkDeleteRunTask(pending))
+	completed, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{UpdatedBefore: time.Now()})
+	require.NoError(t, err)
+	completed.Status = models.BulkTaskStatusCompleted
+	require.NoError(t, store.SaveBulkDeleteRunTask(completed))
+
+	cancelled, err := store.CancelBulkDeleteRunTask(pending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkTaskStatusCancelled, cancelled.Status)
+
+	_, err = store.CancelBulkDeleteRunTask(pending.ID)
+	assert.Equal(t, orm.ErrorBulkTaskFinished, err)
+	_, err = store.CancelBulkDeleteRunTask(completed.ID)
+	assert.Equal(t, orm.ErrorBulkTaskFinished, err)
+	_, err = store.CancelBulkDeleteRunTask("bogus")
+	assert.Equal(t, orm.ErrorNotFound, err)
+
+	found, err := store.FindBulkDeleteRunTask(completed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkTaskStatusCompleted, found.Status)
+}
+
+func TestORM_ArchiveJob(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	archived, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&archived))
+	active, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&active))
+
+	job, err := store.ArchiveJob(archived.ID)
+	require.NoError(t, err)
+	assert.True(t, job.Archived())
+
+	found, err := store.FindJob(archived.ID)
+	require.NoError(t, err)
+	assert.True(t, found.Archived())
+
+	var ids []string
+	require.NoError(t, store.Jobs(func(j models.JobSpec) bool {
+		ids = append(ids, j.ID)
+		return true
+	}))
+	assert.Equal(t, []string{active.ID}, ids)
+
+	_, err = store.ArchiveJob(archived.ID)
+	assert.Equal(t, orm.ErrorJobArchived, err)
+	_, err = store.ArchiveJob("bogus")
+	assert.Equal(t, orm.ErrorNotFound, err)
+}
+
+func TestORM_FindActiveJobByName(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	archived, _ := cltest.NewJobWithWebInitiator()
+	archived.Name = "eth-usd"
+	require.NoError(t, store.SaveJob(&archived))
+	_, err := store.ArchiveJob(archived.ID)
+	require.NoError(t, err)
+
+	_, err = store.FindActiveJobByName("eth-usd")
+	assert.Equal(t, orm.ErrorNotFound, err)
+
+	active, _ := cltest.NewJobWithWebInitiator()
+	active.Name = "eth-usd"
+	require.NoError(t, store.SaveJob(&active))
+
+	found, err := store.FindActiveJobByName("eth-usd")
+	require.NoError(t, err)
+	assert.Equal(t, active.ID, found.ID)
+
+	_, err = store.FindActiveJobByName("btc-usd")
+	assert.Equal(t, orm.ErrorNotFound, err)
+}
+
+func TestORM_UpdateJob(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+	assert.Equal(t, uint32(1), job.Version)
+	initr := job.Initiators[0]
+
+	next, err := job.NewVersion([]byte(`{"initiators":[{"type":"cron","schedule":"* * * * *"}]}`))
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateJob(&next))
+
+	found, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), found.Version)
+	require.Len(t, found.Initiators, 1)
+	assert.Equal(t, models.InitiatorCron, found.Initiators[0].Type)
+	assert.NotEqual(t, initr.ID, found.Initiators[0].ID)
+
+	_, err = store.FindInitiator(initr.ID)
+	assert.Equal(t, orm.ErrorNotFound, err)
+
+	versions, err := store.JobSpecVersions(job.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, uint32(1), versions[0].Version)
+	assert.Equal(t, models.InitiatorWeb, versions[0].Initiators[0].Type)
+	assert.Equal(t, uint32(2), versions[1].Version)
+	assert.Equal(t, models.InitiatorCron, versions[1].Initiators[0].Type)
+
+	v1, err := store.FindJobSpecVersion(job.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, v1.JobID)
+	_, err = store.FindJobSpecVersion(job.ID, 3)
+	assert.Equal(t, orm.ErrorNotFound, err)
+}
+
+func TestORM_UpdateJob_Conflicts(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&job))
+
+	first, err := job.NewVersion([]byte(`{"name":"first"}`))
+	require.NoError(t, err)
+	second, err := job.NewVersion([]byte(`{"name":"second"}`))
+	require.NoError(t, err)
+
+	require.NoError(t, store.UpdateJob(&first))
+	assert.Equal(t, orm.ErrorJobVersionConflict, store.UpdateJob(&second))
+
+	_, err = store.ArchiveJob(job.ID)
+	require.NoError(t, err)
+	third, err := first.NewVersion([]byte(`{"name":"third"}`))
+	require.NoError(t, err)
+	assert.Equal(t, orm.ErrorJobArchived, store.UpdateJob(&third))
+
+	missing := models.NewJob()
+	missing.Version = 1
+	assert.Equal(t, orm.ErrorNotFound, store.UpdateJob(&missing))
+}
+
+func TestORM_JobsSorted_Filter(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	var tagged []models.JobSpec
+	for i := 0; i < 3; i++ {
+		j, _ := cltest.NewJobWithWebInitiator()
+		j.Tags = []string{"eth"}
+		j.CreatedAt = models.Time{Time: time.Now().AddDate(0, 0, i-3)}
+		require.NoError(t, store.SaveJob(&j))
+		tagged = append(tagged, j)
+	}
+	untagged, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.SaveJob(&untagged))
+
+	jobs, count, err := store.JobsSorted(orm.JobSpecFilter{}, orm.Ascending, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+	assert.Len(t, jobs, 4)
+
+	filter := orm.JobSpecFilter{Tags: []string{"eth"}}
+	jobs, count, err = store.JobsSorted(filter, orm.Descending, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, tagged[1].ID, jobs[0].ID)
+
+	jobs, count, err = store.JobsSorted(filter, orm.Ascending, 5, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Empty(t, jobs)
+
+	retagged, err := tagged[0].NewVersion([]byte(`{"name":"feed","tags":["btc","usd"]}`))
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateJob(&retagged))
+
+	jobs, count, err = store.JobsSorted(filter, orm.Ascending, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Len(t, jobs, 2)
+
+	filter = orm.JobSpecFilter{Tags: []string{"btc", "usd"}}
+	jobs, count, err = store.JobsSorted(filter, orm.Ascending, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, retagged.ID, jobs[0].ID)
+
+	filter = orm.JobSpecFilter{Name: "feed", Tags: []string{"eth"}}
+	jobs, count, err = store.JobsSorted(filter, orm.Ascending, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Empty(t, jobs)
+
+	filter = orm.JobSpecFilter{Name: "feed"}
+	jobs, count, err = store.JobsSorted(filter, orm.Ascending, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, retagged.ID, jobs[0].ID)
+}
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,9 +21,11 @@ import (
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/tidwall/gjson"
 	"go.uber.org/multierr"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // LogListeningAddress returns the LogListeningAddress
@@ -50,13 +54,16 @@ func ShowLinkBalance(store *store.Store) ([]map[string]interface{}, error) {
 }
 
 func showBalanceFor(store *store.Store, balanceType requestType) ([]map[string]interface{}, error) {
-	if !store.KeyStore.HasAccounts() {
-		logger.Panic("KeyStore must have an account in order to show balance")
+	accts, err := store.Signer.SigningAccounts()
+	if err != nil {
+		return nil, err
+	} else if len(accts) == 0 {
+		logger.Panic("Signer must have an account in order to show balance")
 	}
 
 	var merr error
 	info := []map[string]interface{}{}
-	for _, account := range store.KeyStore.Accounts() {
+	for _, account := range accts {
 		b, err := showBalanceForAccount(store, account, balanceType)
 		merr = multierr.Append(merr, err)
 		if err == nil {
@@ -114,6 +121,22 @@ func (bt BridgeType) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// Webhook is a presenter for a Webhook which hides its secret.
+type Webhook struct {
+	models.Webhook
+}
+
+// MarshalJSON returns the JSON data of the Webhook without its secret.
+func (w Webhook) MarshalJSON() ([]byte, error) {
+	type Alias models.Webhook
+	return json.Marshal(&struct {
+		Alias
+		Secret string `json:"secret,omitempty"`
+	}{
+		Alias: Alias(w.Webhook),
+	})
+}
+
 // AccountBalance holds the hex representation of the address plus it's ETH & LINK balances
 type AccountBalance struct {
 	Address     string       `json:"address"`
@@ -132,6 +155,205 @@ func (a *AccountBalance) SetID(value string) error {
 	return nil
 }
 
+// Key holds the balances of an account in the keystore along with whether it
+// is used for transactions and, if so, the next nonce it will use, and whether
+// it signs service agreements.
+type Key struct {
+	AccountBalance
+	Active  bool    `json:"active"`
+	Nonce   *uint64 `json:"nonce"`
+	Signing bool    `json:"signing"`
+}
+
+// GetName returns the collection name for jsonapi.
+func (k Key) GetName() string {
+	return "keys"
+}
+
+// SigningAccount is the account the node signs new service agreements with.
+type SigningAccount struct {
+	Address common.Address `json:"address"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (sa SigningAccount) GetID() string {
+	return "signing_account"
+}
+
+// GetName returns the collection name for jsonapi.
+func (sa SigningAccount) GetName() string {
+	return "signing_accounts"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (sa *SigningAccount) SetID(value string) error {
+	return nil
+}
+
+// RunEarnings holds the LINK paid for a job run and the gas spent by the
+// transactions it sent.
+type RunEarnings struct {
+	RunID      string       `json:"runId"`
+	Status     string       `json:"status"`
+	LinkEarned *assets.Link `json:"linkEarned"`
+	GasUsed    *big.Int     `json:"gasUsed"`
+	GasCost    *assets.Eth  `json:"gasCost"`
+}
+
+// JobEarnings totals the LINK earned and gas spent across the runs of a job.
+type JobEarnings struct {
+	JobID      string        `json:"jobId"`
+	RunCount   int           `json:"runCount"`
+	LinkEarned *assets.Link  `json:"linkEarned"`
+	GasUsed    *big.Int      `json:"gasUsed"`
+	GasCost    *assets.Eth   `json:"gasCost"`
+	Runs       []RunEarnings `json:"runs,omitempty"`
+}
+
+// NewJobEarnings returns JobEarnings for the job without any runs.
+func NewJobEarnings(jobID string) JobEarnings {
+	return JobEarnings{
+		JobID:      jobID,
+		LinkEarned: assets.NewLink(0),
+		GasUsed:    big.NewInt(0),
+		GasCost:    assets.NewEth(0),
+	}
+}
+
+// Add includes the run in the job's totals.
+func (je *JobEarnings) Add(re RunEarnings) {
+	je.RunCount++
+	je.LinkEarned.Add(je.LinkEarned, re.LinkEarned)
+	je.GasUsed.Add(je.GasUsed, re.GasUsed)
+	je.GasCost.Add(je.GasCost, re.GasCost)
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (je JobEarnings) GetID() string {
+	return je.JobID
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (je *JobEarnings) SetID(value string) error {
+	je.JobID = value
+	return nil
+}
+
+// EarningsSummary totals the LINK earned and gas spent across every job on
+// the node.
+type EarningsSummary struct {
+	RunCount   int           `json:"runCount"`
+	LinkEarned *assets.Link  `json:"linkEarned"`
+	GasUsed    *big.Int      `json:"gasUsed"`
+	GasCost    *assets.Eth   `json:"gasCost"`
+	Jobs       []JobEarnings `json:"jobs"`
+}
+
+// NewEarningsSummary returns an EarningsSummary without any jobs.
+func NewEarningsSummary() EarningsSummary {
+	return EarningsSummary{
+		LinkEarned: assets.NewLink(0),
+		GasUsed:    big.NewInt(0),
+		GasCost:    assets.NewEth(0),
+		Jobs:       []JobEarnings{},
+	}
+}
+
+// Add includes the job in the node's totals.
+func (es *EarningsSummary) Add(je JobEarnings) {
+	es.RunCount += je.RunCount
+	es.LinkEarned.Add(es.LinkEarned, je.LinkEarned)
+	es.GasUsed.Add(es.GasUsed, je.GasUsed)
+	es.GasCost.Add(es.GasCost, je.GasCost)
+	es.Jobs = append(es.Jobs, je)
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (es EarningsSummary) GetID() string {
+	return "earnings"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (es *EarningsSummary) SetID(value string) error {
+	return nil
+}
+
+// RetainedRun summarizes a run that the run retention policy deletes.
+type RetainedRun struct {
+	RunID     string           `json:"runId"`
+	JobID     string           `json:"jobId"`
+	Status    models.RunStatus `json:"status"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// RunRetention reports the runs the node's retention policy would delete if
+// the reaper ran now.
+type RunRetention struct {
+	models.RunRetentionPolicy
+	Enabled  bool          `json:"enabled"`
+	RunCount int           `json:"runCount"`
+	Runs     []RetainedRun `json:"runs"`
+}
+
+// NewRunRetention returns the report of the policy deleting the runs.
+func NewRunRetention(policy models.RunRetentionPolicy, runs []models.JobRun) RunRetention {
+	rr := RunRetention{
+		RunRetentionPolicy: policy,
+		Enabled:            policy.Enabled(),
+		RunCount:           len(runs),
+		Runs:               []RetainedRun{},
+	}
+	for _, run := range runs {
+		rr.Runs = append(rr.Runs, RetainedRun{
+			RunID:     run.ID,
+			JobID:     run.JobID,
+			Status:    run.Status,
+			UpdatedAt: run.UpdatedAt,
+		})
+	}
+	return rr
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (rr RunRetention) GetID() string {
+	return "run_retention"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (rr *RunRetention) SetID(value string) error {
+	return nil
+}
+
+// Compaction reports whether the database will be compacted the next time
+// the node starts, and the current size of its file in bytes. A requested
+// compaction waits for the node to be restarted.
+type Compaction struct {
+	Requested    bool  `json:"requested"`
+	DatabaseSize int64 `json:"databaseSize"`
+}
+
+// NewCompaction returns the compaction status of the store's database.
+func NewCompaction(orm *orm.ORM) (Compaction, error) {
+	size, err := orm.DatabaseSize()
+	if err != nil {
+		return Compaction{}, err
+	}
+	return Compaction{
+		Requested:    orm.CompactionRequested(),
+		DatabaseSize: size,
+	}, nil
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (c Compaction) GetID() string {
+	return "compaction"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (c *Compaction) SetID(value string) error {
+	return nil
+}
+
 // ConfigWhitelist are the non-secret values of the node
 //
 // If you add an entry here, you should update NewConfigWhitelist and
@@ -142,37 +364,55 @@ type ConfigWhitelist struct {
 }
 
 type whitelist struct {
-	AllowOrigins             string          `json:"allowOrigins"`
-	BridgeResponseURL        string          `json:"bridgeResponseURL,omitempty"`
-	ChainID                  uint64          `json:"ethChainId"`
-	Dev                      bool            `json:"chainlinkDev"`
-	ClientNodeURL            string          `json:"clientNodeUrl"`
-	DatabaseTimeout          time.Duration   `json:"databaseTimeout"`
-	EthereumURL              string          `json:"ethUrl"`
-	EthGasBumpThreshold      uint64          `json:"ethGasBumpThreshold"`
-	EthGasBumpWei            *big.Int        `json:"ethGasBumpWei"`
-	EthGasPriceDefault       *big.Int        `json:"ethGasPriceDefault"`
-	JSONConsole              bool            `json:"jsonConsole"`
-	LinkContractAddress      string          `json:"linkContractAddress"`
-	LogLevel                 store.LogLevel  `json:"logLevel"`
-	LogToDisk                bool            `json:"logToDisk"`
-	MinimumContractPayment   *assets.Link    `json:"minimumContractPayment"`
-	MinimumRequestExpiration uint64          `json:"minimumRequestExpiration"`
-	MinIncomingConfirmations uint64          `json:"minIncomingConfirmations"`
-	MinOutgoingConfirmations uint64          `json:"minOutgoingConfirmations"`
-	OracleContractAddress    *common.Address `json:"oracleContractAddress"`
-	Port                     uint16          `json:"chainlinkPort"`
-	ReaperExpiration         time.Duration   `json:"reaperExpiration"`
-	RootDir                  string          `json:"root"`
-	SessionTimeout           time.Duration   `json:"sessionTimeout"`
-	TLSHost                  string          `json:"chainlinkTLSHost"`
-	TLSPort                  uint16          `json:"chainlinkTLSPort"`
+	AllowOrigins             string             `json:"allowOrigins"`
+	BackupCompress           bool               `json:"backupCompress"`
+	BackupDir                string             `json:"backupDir"`
+	BackupInterval           time.Duration      `json:"backupInterval"`
+	BackupRetention          uint64             `json:"backupRetention"`
+	BridgeResponseURL        string             `json:"bridgeResponseURL,omitempty"`
+	ChainID                  uint64             `json:"ethChainId"`
+	Dev                      bool               `json:"chainlinkDev"`
+	ClientNodeURL            string             `json:"clientNodeUrl"`
+	DatabaseTimeout          time.Duration      `json:"databaseTimeout"`
+	EthereumURL              string             `json:"ethUrl"`
+	EthTxHoldOnLowBalance    bool               `json:"ethTxHoldOnLowBalance"`
+	EthBalanceThreshold      *assets.Eth        `json:"ethBalanceThreshold"`
+	EthGasBumpThreshold      uint64             `json:"ethGasBumpThreshold"`
+	EthGasBumpWei            *big.Int           `json:"ethGasBumpWei"`
+	EthGasPriceDefault       *big.Int           `json:"ethGasPriceDefault"`
+	JSONConsole              bool               `json:"jsonConsole"`
+	LinkContractAddress      string             `json:"linkContractAddress"`
+	LinkSweepAddress         *common.Address    `json:"linkSweepAddress"`
+	LinkSweepBlocks          uint64             `json:"linkSweepBlocks"`
+	LinkSweepReserve         *assets.Link       `json:"linkSweepReserve"`
+	LinkSweepThreshold       *assets.Link       `json:"linkSweepThreshold"`
+	LogLevel                 store.LogLevel     `json:"logLevel"`
+	LogToDisk                bool               `json:"logToDisk"`
+	MinimumContractPayment   *assets.Link       `json:"minimumContractPayment"`
+	MinimumRequestExpiration uint64             `json:"minimumRequestExpiration"`
+	MinIncomingConfirmations uint64             `json:"minIncomingConfirmations"`
+	MinOutgoingConfirmations uint64             `json:"minOutgoingConfirmations"`
+	OracleContractAddress    *common.Address    `json:"oracleContractAddress"`
+	Port                     uint16             `json:"chainlinkPort"`
+	ReaperExpiration         time.Duration      `json:"reaperExpiration"`
+	ReaperInterval           time.Duration      `json:"reaperInterval"`
+	RootDir                  string             `json:"root"`
+	RunRetentionMaxAge       time.Duration      `json:"runRetentionMaxAge"`
+	RunRetentionMaxCount     uint64             `json:"runRetentionMaxCount"`
+	RunRetentionStatuses     []models.RunStatus `json:"runRetentionStatuses"`
+	SessionTimeout           time.Duration      `json:"sessionTimeout"`
+	SignerURL                string             `json:"signerUrl,omitempty"`
+	SigningAccount           string             `json:"signingAccount,omitempty"`
+	TLSHost                  string             `json:"chainlinkTLSHost"`
+	TLSPort                  uint16             `json:"chainlinkTLSPort"`
+	WebhookMaxAttempts       uint64             `json:"webhookMaxAttempts"`
+	WebhookPendingThreshold  time.Duration      `json:"webhookPendingThreshold"`
 }
 
 // NewConfigWhitelist creates an instance of ConfigWhitelist
 func NewConfigWhitelist(store *store.Store) (ConfigWhitelist, error) {
 	config := store.Config
-	account, err := store.KeyStore.GetFirstAccount()
+	account, err := store.Signer.GetFirstAccount()
 	if err != nil {
 		return ConfigWhitelist{}, err
 	}
@@ -181,17 +421,27 @@ func NewConfigWhitelist(store *store.Store) (ConfigWhitelist, error) {
 		AccountAddress: account.Address.Hex(),
 		whitelist: whitelist{
 			AllowOrigins:             config.AllowOrigins(),
+			BackupCompress:           config.BackupCompress(),
+			BackupDir:                config.BackupDir(),
+			BackupInterval:           config.BackupInterval(),
+			BackupRetention:          config.BackupRetention(),
 			BridgeResponseURL:        config.BridgeResponseURL().String(),
 			ChainID:                  config.ChainID(),
 			Dev:                      config.Dev(),
 			ClientNodeURL:            config.ClientNodeURL(),
 			DatabaseTimeout:          config.DatabaseTimeout(),
 			EthereumURL:              config.EthereumURL(),
+			EthTxHoldOnLowBalance:    config.EthTxHoldOnLowBalance(),
+			EthBalanceThreshold:      config.EthBalanceThreshold(),
 			EthGasBumpThreshold:      config.EthGasBumpThreshold(),
 			EthGasBumpWei:            config.EthGasBumpWei(),
 			EthGasPriceDefault:       config.EthGasPriceDefault(),
 			JSONConsole:              config.JSONConsole(),
 			LinkContractAddress:      config.LinkContractAddress(),
+			LinkSweepAddress:         config.LinkSweepAddress(),
+			LinkSweepBlocks:          config.LinkSweepBlocks(),
+			LinkSweepReserve:         config.LinkSweepReserve(),
+			LinkSweepThreshold:       config.LinkSweepThreshold(),
 			LogLevel:                 config.LogLevel(),
 			LogToDisk:                config.LogToDisk(),
 			MinimumContractPayment:   config.MinimumContractPayment(),
@@ -201,10 +451,18 @@ func NewConfigWhitelist(store *store.Store) (ConfigWhitelist, error) {
 			OracleContractAddress:    config.OracleContractAddress(),
 			Port:                     config.Port(),
 			ReaperExpiration:         config.ReaperExpiration(),
+			ReaperInterval:           config.ReaperInterval(),
 			RootDir:                  config.RootDir(),
+			RunRetentionMaxAge:       config.RunRetentionMaxAge(),
+			RunRetentionMaxCount:     config.RunRetentionMaxCount(),
+			RunRetentionStatuses:     config.RunRetentionStatuses(),
 			SessionTimeout:           config.SessionTimeout(),
+			SignerURL:                config.SignerURL(),
+			SigningAccount:           config.SigningAccount(),
 			TLSHost:                  config.TLSHost(),
 			TLSPort:                  config.TLSPort(),
+			WebhookMaxAttempts:       config.WebhookMaxAttempts(),
+			WebhookPendingThreshold:  config.WebhookPendingThreshold(),
 		},
 	}, nil
 }
@@ -262,14 +520,22 @@ type JobSpec struct {
 }
 
 // MarshalJSON returns the JSON data of the Job and its Initiators.
+// Scheduled Initiators also show the next time they fire.
 func (job JobSpec) MarshalJSON() ([]byte, error) {
 	type Alias JobSpec
-	pis := make([]Initiator, len(job.Initiators))
+	now := time.Now()
+	pis := make([]scheduledInitiator, len(job.Initiators))
 	for i, modelInitr := range job.Initiators {
-		pis[i] = Initiator{modelInitr}
+		pis[i] = scheduledInitiator{Initiator: Initiator{modelInitr}}
+		if modelInitr.Type != models.InitiatorCron || job.Ended(now) {
+			continue
+		}
+		if next, err := modelInitr.NextFireAt(now); err == nil && !next.IsZero() {
+			pis[i].NextFireAt = &next
+		}
 	}
 	return json.Marshal(&struct {
-		Initiators []Initiator `json:"initiators"`
+		Initiators []scheduledInitiator `json:"initiators"`
 		Alias
 	}{
 		pis,
@@ -277,6 +543,161 @@ func (job JobSpec) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// scheduledInitiator is an Initiator along with the next time it fires,
+// if it runs on a schedule.
+type scheduledInitiator struct {
+	Initiator
+	NextFireAt *time.Time
+}
+
+// MarshalJSON returns the JSON data of the Initiator, adding nextFireAt
+// when it is known.
+func (si scheduledInitiator) MarshalJSON() ([]byte, error) {
+	p, err := initiatorParams(si.Initiator)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return json.Marshal(&struct {
+		Type       string      `json:"type"`
+		Params     interface{} `json:"params"`
+		NextFireAt *time.Time  `json:"nextFireAt,omitempty"`
+	}{si.Type, p, si.NextFireAt})
+}
+
+// JobSpecExport is the portable form of a JobSpec. It leaves out the IDs
+// and state assigned by the node, so it can be created on any node as is.
+type JobSpecExport struct {
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Initiators  []Initiator       `json:"initiators"`
+	Tasks       []models.TaskSpec `json:"tasks"`
+	StartAt     null.Time         `json:"startAt"`
+	EndAt       null.Time         `json:"endAt"`
+}
+
+// NewJobSpecExport returns the portable form of the JobSpec.
+func NewJobSpecExport(job models.JobSpec) JobSpecExport {
+	initrs := make([]Initiator, len(job.Initiators))
+	for i, initr := range job.Initiators {
+		initr.Ran = false
+		initrs[i] = Initiator{initr}
+	}
+	return JobSpecExport{
+		Name:        job.Name,
+		Description: job.Description,
+		Tags:        job.Tags,
+		Initiators:  initrs,
+		Tasks:       job.Tasks,
+		StartAt:     job.StartAt,
+		EndAt:       job.EndAt,
+	}
+}
+
+// JobSyncResult is what jobs sync did, or would do, with the job of
+// the given name. Drift lists the fields that differ from the spec file.
+type JobSyncResult struct {
+	Name   string   `json:"name"`
+	JobID  string   `json:"jobId"`
+	Action string   `json:"action"`
+	Drift  []string `json:"drift,omitempty"`
+}
+
+// JobSpecChange is a value that differs between two versions of a job, at
+// its dot separated path in their exported form.
+type JobSpecChange struct {
+	Path string      `json:"path"`
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// JobSpecDiff lists the changes made to a job from one of its versions
+// to another.
+type JobSpecDiff struct {
+	JobID   string          `json:"jobId"`
+	From    uint32          `json:"from"`
+	To      uint32          `json:"to"`
+	Changes []JobSpecChange `json:"changes"`
+}
+
+// NewJobSpecDiff compares two versions of a job by their exported form, so
+// that IDs and state assigned by the node are left out.
+func NewJobSpecDiff(from, to models.JobSpecVersion) (JobSpecDiff, error) {
+	diff := JobSpecDiff{
+		JobID:   to.JobID,
+		From:    from.Version,
+		To:      to.Version,
+		Changes: []JobSpecChange{},
+	}
+	before, err := flattenJobSpecVersion(from)
+	if err != nil {
+		return diff, err
+	}
+	after, err := flattenJobSpecVersion(to)
+	if err != nil {
+		return diff, err
+	}
+
+	paths := []string{}
+	for path := range before {
+		paths = append(paths, path)
+	}
+	for path := range after {
+		if _, ok := before[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if !reflect.DeepEqual(before[path], after[path]) {
+			diff.Changes = append(diff.Changes, JobSpecChange{
+				Path: path,
+				From: before[path],
+				To:   after[path],
+			})
+		}
+	}
+	return diff, nil
+}
+
+func flattenJobSpecVersion(v models.JobSpecVersion) (map[string]interface{}, error) {
+	b, err := json.Marshal(NewJobSpecExport(models.JobSpec{JobSpecRequest: v.JobSpecRequest}))
+	if err != nil {
+		return nil, err
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return nil, err
+	}
+	flat := map[string]interface{}{}
+	flattenJSON("", decoded, flat)
+	return flat, nil
+}
+
+func flattenJSON(path string, value interface{}, flat map[string]interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			flattenJSON(joinPath(path, key), child, flat)
+		}
+	case []interface{}:
+		for i, child := range v {
+			flattenJSON(joinPath(path, strconv.Itoa(i)), child, flat)
+		}
+	default:
+		flat[path] = v
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
 // FriendlyCreatedAt returns a human-readable string of the Job's
 // CreatedAt field.
 func (job JobSpec) FriendlyCreatedAt() string {
@@ -301,6 +722,11 @@ func (job JobSpec) FriendlyEndAt() string {
 	return ""
 }
 
+// FriendlyTags returns the Job's tags as a comma separated string.
+func (job JobSpec) FriendlyTags() string {
+	return strings.Join(job.Tags, ", ")
+}
+
 // FriendlyInitiators returns the list of Initiator types as
 // a comma separated string.
 func (job JobSpec) FriendlyInitiators() string {
@@ -347,8 +773,16 @@ func initiatorParams(i Initiator) (interface{}, error) {
 		return struct{}{}, nil
 	case models.InitiatorCron:
 		return struct {
-			Schedule models.Cron `json:"schedule"`
-		}{i.Schedule}, nil
+			Schedule models.Cron     `json:"schedule"`
+			TimeZone string          `json:"timeZone,omitempty"`
+			Jitter   models.Duration `json:"jitter,omitempty"`
+			CatchUp  string          `json:"catchUp,omitempty"`
+		}{i.Schedule, i.TimeZone, i.Jitter, i.CatchUp}, nil
+	case models.InitiatorInterval:
+		return struct {
+			Period models.Duration `json:"period,omitempty"`
+			Blocks uint64          `json:"blocks,omitempty"`
+		}{i.Period, i.Blocks}, nil
 	case models.InitiatorRunAt:
 		return struct {
 			Time models.Time `json:"time"`
@@ -429,9 +863,51 @@ type ServiceAgreement struct {
 	models.ServiceAgreement
 }
 
-// MarshalJSON returns the JSON data of the ServiceAgreement.
+// MarshalJSON returns the JSON data of the ServiceAgreement's request, along
+// with the address it was signed with and its lifecycle status.
 func (sa ServiceAgreement) MarshalJSON() ([]byte, error) {
-	return []byte(sa.ServiceAgreement.RequestBody), nil
+	body, err := models.ParseJSON([]byte(sa.ServiceAgreement.RequestBody))
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := map[string]interface{}{
+		"signingAddress": sa.SigningAddress.Hex(),
+		"createdAt":      sa.CreatedAt.ISO8601(),
+		"status":         sa.Status(time.Now()),
+	}
+	if sa.Initiated() {
+		attributes["initiatedTxHash"] = sa.InitiatedTxHash.Hex()
+		attributes["initiatedBlockNumber"] = sa.InitiatedBlockNumber
+	}
+	for key, value := range attributes {
+		if body, err = body.Add(key, value); err != nil {
+			return nil, err
+		}
+	}
+	return body.MarshalJSON()
+}
+
+// UnmarshalJSON parses the encumbrance and the attributes added by
+// MarshalJSON back into the ServiceAgreement.
+func (sa *ServiceAgreement) UnmarshalJSON(input []byte) error {
+	var attributes struct {
+		CreatedAt            models.Time    `json:"createdAt"`
+		SigningAddress       common.Address `json:"signingAddress"`
+		InitiatedTxHash      common.Hash    `json:"initiatedTxHash"`
+		InitiatedBlockNumber uint64         `json:"initiatedBlockNumber"`
+	}
+	if err := json.Unmarshal(input, &attributes); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(input, &sa.Encumbrance); err != nil {
+		return err
+	}
+	sa.CreatedAt = attributes.CreatedAt
+	sa.SigningAddress = attributes.SigningAddress
+	sa.InitiatedTxHash = attributes.InitiatedTxHash
+	sa.InitiatedBlockNumber = attributes.InitiatedBlockNumber
+	return nil
 }
 
 // FriendlyCreatedAt returns the ServiceAgreement's created at time in a human
@@ -446,6 +922,17 @@ func (sa ServiceAgreement) FriendlyExpiration() string {
 	return fmt.Sprintf("%v seconds", sa.Encumbrance.Expiration)
 }
 
+// FriendlyEndAt returns the ServiceAgreement's Encumbrance end time in a human
+// readable format.
+func (sa ServiceAgreement) FriendlyEndAt() string {
+	return sa.Encumbrance.EndAt.HumanString()
+}
+
+// FriendlyStatus returns the ServiceAgreement's current status.
+func (sa ServiceAgreement) FriendlyStatus() string {
+	return string(sa.Status(time.Now()))
+}
+
 // FriendlyPayment returns the ServiceAgreement's Encumbrance payment amount in
 // a human readable format.
 func (sa ServiceAgreement) FriendlyPayment() string {
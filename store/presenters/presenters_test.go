@@ -35,6 +35,7 @@ func TestPresenterInitiatorHasCorrectKeys(t *testing.T) {
 		{MI{Type: models.InitiatorCron, InitiatorParams: MIP{Schedule: models.Cron("* * * * *")}}, []string{"schedule"}},
 		{MI{Type: models.InitiatorRunAt, InitiatorParams: MIP{Time: models.Time{Time: now}}}, []string{"time", "ran"}},
 		{MI{Type: models.InitiatorEthLog, InitiatorParams: MIP{Address: address}}, []string{"address"}},
+		{MI{Type: models.InitiatorInterval, InitiatorParams: MIP{Blocks: 10}}, []string{"blocks"}},
 	}
 
 	for _, test := range tests {
@@ -152,6 +153,77 @@ func TestPresenter_FriendlyBigInt(t *testing.T) {
 	}
 }
 
+func TestJobSpec_MarshalJSON_NextFireAt(t *testing.T) {
+	t.Parallel()
+
+	j, _ := cltest.NewJobWithSchedule("0 0 * * * *")
+	j.Initiators = append(j.Initiators, models.Initiator{Type: models.InitiatorWeb})
+
+	b, err := json.Marshal(presenters.JobSpec{JobSpec: j})
+	require.NoError(t, err)
+
+	js := gjson.ParseBytes(b)
+	next := js.Get("initiators.0.nextFireAt")
+	require.True(t, next.Exists())
+	assert.True(t, next.Time().After(time.Now()))
+	assert.Equal(t, "0 0 * * * *", js.Get("initiators.0.params.schedule").String())
+	assert.False(t, js.Get("initiators.1.nextFireAt").Exists())
+}
+
+func TestNewJobSpecExport(t *testing.T) {
+	t.Parallel()
+
+	j, _ := cltest.NewJobWithRunAtInitiator(time.Now())
+	j.Name = "run-once"
+	j.Initiators[0].ID = 3
+	j.Initiators[0].JobID = j.ID
+	j.Initiators[0].Ran = true
+
+	b, err := json.Marshal(presenters.NewJobSpecExport(j))
+	require.NoError(t, err)
+
+	js := gjson.ParseBytes(b)
+	assert.Equal(t, "run-once", js.Get("name").String())
+	assert.False(t, js.Get("id").Exists())
+	initr := js.Get("initiators.0")
+	assert.Equal(t, models.InitiatorRunAt, initr.Get("type").String())
+	assert.False(t, initr.Get("id").Exists())
+	assert.False(t, initr.Get("jobId").Exists())
+	assert.False(t, initr.Get("params.ran").Bool())
+	assert.Equal(t, string(j.Tasks[0].Type), js.Get("tasks.0.type").String())
+}
+
+func TestNewJobSpecDiff(t *testing.T) {
+	t.Parallel()
+
+	j, _ := cltest.NewJobWithWebInitiator()
+	j.Name = "eth-usd"
+	j.Tags = []string{"eth", "usd"}
+	j.Version = 1
+	from := models.NewJobSpecVersion(j)
+
+	j.Name = "btc-usd"
+	j.Tags = []string{"eth"}
+	j.Initiators[0].ID = 9
+	j.Version = 2
+	to := models.NewJobSpecVersion(j)
+
+	diff, err := presenters.NewJobSpecDiff(from, to)
+	require.NoError(t, err)
+
+	assert.Equal(t, j.ID, diff.JobID)
+	assert.Equal(t, uint32(1), diff.From)
+	assert.Equal(t, uint32(2), diff.To)
+	assert.Equal(t, []presenters.JobSpecChange{
+		{Path: "name", From: "eth-usd", To: "btc-usd"},
+		{Path: "tags.1", From: "usd", To: nil},
+	}, diff.Changes)
+
+	same, err := presenters.NewJobSpecDiff(to, to)
+	require.NoError(t, err)
+	assert.Equal(t, []presenters.JobSpecChange{}, same.Changes)
+}
+
 func TestBridgeType_MarshalJSON(t *testing.T) {
 	t.Parallel()
 	input := models.BridgeType{
@@ -178,7 +250,39 @@ func TestServiceAgreement_MarshalJSON(t *testing.T) {
 	psa := presenters.ServiceAgreement{ServiceAgreement: sa}
 	output, err := psa.MarshalJSON()
 	assert.NoError(t, err)
-	assert.Equal(t, cltest.NormalizedJSON(input), string(output))
+
+	assert.Equal(t, cltest.MockSigner{}.Address().Hex(), gjson.GetBytes(output, "signingAddress").String())
+	assert.Equal(t, string(sa.Status(time.Now())), gjson.GetBytes(output, "status").String())
+	assert.False(t, gjson.GetBytes(output, "initiatedTxHash").Exists())
+	body, err := models.ParseJSON(output)
+	require.NoError(t, err)
+	for _, key := range []string{"signingAddress", "createdAt", "status"} {
+		body, err = body.Delete(key)
+		require.NoError(t, err)
+	}
+	assert.JSONEq(t, cltest.NormalizedJSON(input), body.String())
+}
+
+func TestServiceAgreement_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	input := cltest.LoadJSON("../../internal/fixtures/web/hello_world_agreement.json")
+	sa, err := cltest.ServiceAgreementFromString(string(input))
+	require.NoError(t, err)
+	sa.InitiatedTxHash = cltest.NewHash()
+	sa.InitiatedBlockNumber = 42
+	output, err := json.Marshal(presenters.ServiceAgreement{ServiceAgreement: sa})
+	require.NoError(t, err)
+	assert.Equal(t, sa.InitiatedTxHash.Hex(), gjson.GetBytes(output, "initiatedTxHash").String())
+
+	var psa presenters.ServiceAgreement
+	require.NoError(t, json.Unmarshal(output, &psa))
+	assert.Equal(t, sa.Encumbrance.Payment, psa.Encumbrance.Payment)
+	assert.True(t, sa.Encumbrance.EndAt.Equal(psa.Encumbrance.EndAt.Time))
+	assert.Equal(t, sa.SigningAddress, psa.SigningAddress)
+	assert.Equal(t, sa.InitiatedTxHash, psa.InitiatedTxHash)
+	assert.Equal(t, uint64(42), psa.InitiatedBlockNumber)
+	assert.Equal(t, sa.CreatedAt.ISO8601(), psa.CreatedAt.ISO8601())
 }
 
 func TestPresenter_NewConfigWhitelist_Ok(t *testing.T) {
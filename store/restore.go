@@ -0,0 +1,137 @@
+package store
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store/migrations"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"github.com/smartcontractkit/chainlink/utils"
+)
+
+// RestoreDatabase replaces the node's database with the backup at
+// backupPath, which may be gzip compressed. The backup is copied next to the
+// database, checked to be an intact Bolt file written by this or an older
+// version of the node, and brought up to date by running any pending
+// migrations. Only then is it moved over the database, whose previous
+// contents are kept alongside with a .pre-restore suffix. The node must not
+// be running.
+func RestoreDatabase(config Config, backupPath string) error {
+	if err := os.MkdirAll(config.RootDir(), os.FileMode(0700)); err != nil {
+		return err
+	}
+	dbPath := config.DatabasePath()
+	timeout := config.DatabaseTimeout()
+
+	// Holding the current database open keeps the node from starting while
+	// the backup is checked.
+	var current *orm.ORM
+	if utils.FileExists(dbPath) {
+		var err error
+		if current, err = orm.NewORM(dbPath, timeout); err != nil {
+			return fmt.Errorf("unable to lock database %s, stop the node before restoring: %+v", dbPath, err)
+		}
+		defer current.Close()
+	}
+
+	restoring := dbPath + ".restoring"
+	if err := prepareRestore(backupPath, restoring, timeout); err != nil {
+		os.Remove(restoring)
+		return err
+	}
+
+	if current != nil {
+		if err := current.Close(); err != nil {
+			os.Remove(restoring)
+			return err
+		}
+		previous := dbPath + ".pre-restore"
+		if err := os.Remove(previous); err != nil && !os.IsNotExist(err) {
+			os.Remove(restoring)
+			return err
+		} else if err := os.Link(dbPath, previous); err != nil {
+			os.Remove(restoring)
+			return err
+		}
+		logger.Infow("Kept previous database", "path", previous)
+	}
+	if err := os.Rename(restoring, dbPath); err != nil {
+		os.Remove(restoring)
+		return err
+	}
+	logger.Infow("Restored database", "path", dbPath, "backup", backupPath)
+	return nil
+}
+
+// prepareRestore copies the backup to dst, verifies it and migrates it.
+func prepareRestore(backupPath, dst string, timeout time.Duration) error {
+	if err := copyBackup(backupPath, dst); err != nil {
+		return fmt.Errorf("unable to copy backup %s: %+v", backupPath, err)
+	}
+
+	restored, err := orm.NewORM(dst, timeout)
+	if err != nil {
+		return fmt.Errorf("backup %s is not a Bolt database: %+v", backupPath, err)
+	}
+	defer restored.Close()
+
+	if err := restored.VerifyIntegrity(); err != nil {
+		return fmt.Errorf("backup %s is corrupt: %+v", backupPath, err)
+	}
+
+	status, err := migrations.Status(restored)
+	if err != nil {
+		return fmt.Errorf("unable to read migrations of backup %s: %+v", backupPath, err)
+	} else if len(status.Run) == 0 {
+		return fmt.Errorf("backup %s has no migrations, it is not a Chainlink database", backupPath)
+	} else if len(status.Unknown) > 0 {
+		return fmt.Errorf("backup %s was written by a newer version of the node, with unknown migrations %v", backupPath, status.Unknown)
+	}
+
+	if len(status.Pending) > 0 {
+		logger.Infow("Migrating backup", "pending", status.Pending)
+		if err := migrations.Migrate(restored); err != nil {
+			return fmt.Errorf("unable to migrate backup %s: %+v", backupPath, err)
+		}
+	}
+	return restored.Close()
+}
+
+// copyBackup copies the backup at src to dst, decompressing it if it is
+// gzipped.
+func copyBackup(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	reader := bufio.NewReader(in)
+	var r io.Reader = reader
+	if magic, err := reader.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, r); err != nil {
+		return err
+	} else if err := out.Sync(); err != nil {
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,160 @@
+package store_test
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/migrations"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeBackup saves a job to a new store and writes a backup of it to a file
+// in the store's root directory, after letting prepare change the store.
+func writeBackup(t *testing.T, compress bool, prepare func(*store.Store)) (string, models.JobSpec, func()) {
+	s, cleanup := cltest.NewStore()
+	job, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, s.SaveJob(&job))
+	prepare(s)
+
+	path := filepath.Join(s.Config.RootDir(), "backup.bolt")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+	if compress {
+		gz := gzip.NewWriter(file)
+		_, err = s.WriteBackup(gz)
+		require.NoError(t, err)
+		require.NoError(t, gz.Close())
+	} else {
+		_, err = s.WriteBackup(file)
+		require.NoError(t, err)
+	}
+	return path, job, cleanup
+}
+
+func newRestoreConfig(t *testing.T) (store.Config, func()) {
+	config, cleanup := cltest.NewConfig()
+	config.Set("DATABASE_TIMEOUT", "10ms")
+	return config.Config, func() {
+		cleanup()
+		os.RemoveAll(config.RootDir())
+	}
+}
+
+func TestRestoreDatabase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		compress bool
+		existing bool
+		prepare  func(*store.Store)
+	}{
+		{"uncompressed", false, false, func(*store.Store) {}},
+		{"compressed", true, false, func(*store.Store) {}},
+		{"replacing database", false, true, func(*store.Store) {}},
+		{"pending migration", false, false, func(s *store.Store) {
+			status, err := migrations.Status(s.ORM)
+			require.NoError(t, err)
+			latest := migrations.MigrationTimestamp{Timestamp: status.Run[len(status.Run)-1]}
+			require.NoError(t, s.DeleteStruct(&latest))
+		}},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			backup, job, cleanup := writeBackup(t, test.compress, test.prepare)
+			defer cleanup()
+			config, cleanup := newRestoreConfig(t)
+			defer cleanup()
+
+			if test.existing {
+				require.NoError(t, os.MkdirAll(config.RootDir(), 0700))
+				existing, err := orm.NewORM(config.DatabasePath(), time.Second)
+				require.NoError(t, err)
+				require.NoError(t, existing.Close())
+			}
+
+			require.NoError(t, store.RestoreDatabase(config, backup))
+			assert.False(t, utils.FileExists(config.DatabasePath()+".restoring"))
+			assert.Equal(t, test.existing, utils.FileExists(config.DatabasePath()+".pre-restore"))
+
+			restored, err := orm.NewORM(config.DatabasePath(), time.Second)
+			require.NoError(t, err)
+			defer restored.Close()
+			_, err = restored.FindJob(job.ID)
+			assert.NoError(t, err)
+			status, err := migrations.Status(restored)
+			require.NoError(t, err)
+			assert.Empty(t, status.Pending)
+		})
+	}
+}
+
+func TestRestoreDatabase_Rejected(t *testing.T) {
+	t.Parallel()
+
+	backup, _, cleanup := writeBackup(t, false, func(s *store.Store) {
+		require.NoError(t, s.Save(&migrations.MigrationTimestamp{Timestamp: "9999999999"}))
+	})
+	defer cleanup()
+	dir := filepath.Dir(backup)
+
+	garbage := filepath.Join(dir, "garbage.bolt")
+	require.NoError(t, ioutil.WriteFile(garbage, []byte("not a database"), 0600))
+
+	empty := filepath.Join(dir, "empty.bolt")
+	db, err := orm.NewORM(empty, time.Second)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"missing", filepath.Join(dir, "missing.bolt")},
+		{"not bolt", garbage},
+		{"no migrations", empty},
+		{"newer version", backup},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			config, cleanup := newRestoreConfig(t)
+			defer cleanup()
+
+			assert.Error(t, store.RestoreDatabase(config, test.path))
+			assert.False(t, utils.FileExists(config.DatabasePath()))
+			assert.False(t, utils.FileExists(config.DatabasePath()+".restoring"))
+		})
+	}
+}
+
+func TestRestoreDatabase_NodeRunning(t *testing.T) {
+	t.Parallel()
+
+	backup, _, cleanup := writeBackup(t, false, func(*store.Store) {})
+	defer cleanup()
+	config, cleanup := newRestoreConfig(t)
+	defer cleanup()
+
+	require.NoError(t, os.MkdirAll(config.RootDir(), 0700))
+	running, err := orm.NewORM(config.DatabasePath(), time.Second)
+	require.NoError(t, err)
+	defer running.Close()
+
+	assert.Error(t, store.RestoreDatabase(config, backup))
+	assert.False(t, utils.FileExists(config.DatabasePath()+".restoring"))
+}
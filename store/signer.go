@@ -0,0 +1,169 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
+)
+
+// Signer holds the node's accounts and signs transactions and service
+// agreements on their behalf, wherever the keys are kept.
+type Signer interface {
+	SigningAccounts() ([]accounts.Account, error)
+	GetFirstAccount() (accounts.Account, error)
+	SignTx(account accounts.Account, tx *types.Transaction, chainID uint64) (*types.Transaction, error)
+	SignWith(account accounts.Account, input []byte) (models.Signature, error)
+}
+
+// AccountSigner signs service agreements with a single account of a Signer.
+type AccountSigner struct {
+	Signer  Signer
+	Account accounts.Account
+}
+
+// Sign signs the Keccak256 hash of the input with the account.
+func (as AccountSigner) Sign(input []byte) (models.Signature, error) {
+	return as.Signer.SignWith(as.Account, input)
+}
+
+// Address returns the address of the account.
+func (as AccountSigner) Address() common.Address {
+	return as.Account.Address
+}
+
+// SigningAccounts returns the accounts in the keystore.
+func (ks *KeyStore) SigningAccounts() ([]accounts.Account, error) {
+	return ks.Accounts(), nil
+}
+
+// Caller performs JSON-RPC calls.
+type Caller interface {
+	Call(result interface{}, method string, args ...interface{}) error
+}
+
+// RemoteSigner keeps keys out of the node by delegating signing to a
+// clef-like service over JSON-RPC. The service must implement eth_accounts,
+// eth_signTransaction and account_signHash, the last of which signs a 32 byte
+// digest without any prefix, matching the file keystore's signatures.
+type RemoteSigner struct {
+	Caller
+}
+
+// NewRemoteSigner connects to the signer at the url, which can be http(s),
+// ws(s) or an IPC path.
+func NewRemoteSigner(url string) (*RemoteSigner, error) {
+	client, err := rpc.Dial(url)
+	if err != nil {
+		return nil, fmt.Errorf("unable to dial remote signer: %v", err)
+	}
+	return &RemoteSigner{client}, nil
+}
+
+// SigningAccounts returns the accounts the remote signer can sign for.
+func (rs *RemoteSigner) SigningAccounts() ([]accounts.Account, error) {
+	addresses := []common.Address{}
+	if err := rs.Call(&addresses, "eth_accounts"); err != nil {
+		return nil, err
+	}
+	accts := make([]accounts.Account, len(addresses))
+	for i, address := range addresses {
+		accts[i] = accounts.Account{Address: address}
+	}
+	return accts, nil
+}
+
+// GetFirstAccount returns the first account the remote signer can sign for.
+func (rs *RemoteSigner) GetFirstAccount() (accounts.Account, error) {
+	accts, err := rs.SigningAccounts()
+	if err != nil {
+		return accounts.Account{}, err
+	}
+	if len(accts) == 0 {
+		return accounts.Account{}, errors.New("No Ethereum Accounts configured")
+	}
+	return accts[0], nil
+}
+
+// RemoteSignTxArgs are the parameters of an eth_signTransaction request.
+type RemoteSignTxArgs struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      hexutil.Uint64  `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Nonce    hexutil.Uint64  `json:"nonce"`
+	Data     hexutil.Bytes   `json:"data"`
+	ChainID  *hexutil.Big    `json:"chainId"`
+}
+
+// RemoteSignTxResult is the response to an eth_signTransaction request.
+type RemoteSignTxResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// SignTx asks the remote signer to sign the transaction, checking that the
+// signature returned is from the requested account.
+func (rs *RemoteSigner) SignTx(account accounts.Account, tx *types.Transaction, chainID uint64) (*types.Transaction, error) {
+	args := RemoteSignTxArgs{
+		From:     account.Address,
+		To:       tx.To(),
+		Gas:      hexutil.Uint64(tx.Gas()),
+		GasPrice: (*hexutil.Big)(tx.GasPrice()),
+		Value:    (*hexutil.Big)(tx.Value()),
+		Nonce:    hexutil.Uint64(tx.Nonce()),
+		Data:     tx.Data(),
+		ChainID:  (*hexutil.Big)(new(big.Int).SetUint64(chainID)),
+	}
+
+	var result RemoteSignTxResult
+	if err := rs.Call(&result, "eth_signTransaction", args); err != nil {
+		return nil, err
+	}
+
+	signed := &types.Transaction{}
+	if err := rlp.DecodeBytes(result.Raw, signed); err != nil {
+		return nil, fmt.Errorf("unable to decode signed transaction: %v", err)
+	}
+	signer := types.NewEIP155Signer(new(big.Int).SetUint64(chainID))
+	if from, err := types.Sender(signer, signed); err != nil {
+		return nil, err
+	} else if from != account.Address {
+		return nil, fmt.Errorf("remote signer signed transaction as %s instead of %s", from.Hex(), account.Address.Hex())
+	}
+	return signed, nil
+}
+
+// SignWith asks the remote signer to sign the Keccak256 hash of the input
+// with the account.
+func (rs *RemoteSigner) SignWith(account accounts.Account, input []byte) (models.Signature, error) {
+	hash, err := utils.Keccak256(input)
+	if err != nil {
+		return models.Signature{}, err
+	}
+
+	var output hexutil.Bytes
+	if err := rs.Call(&output, "account_signHash", account.Address, hexutil.Bytes(hash)); err != nil {
+		return models.Signature{}, err
+	}
+	var signature models.Signature
+	signature.SetBytes(output)
+	return signature, nil
+}
+
+// NewSigner returns a RemoteSigner when the config has a signer url, and
+// otherwise the file keystore.
+func NewSigner(config Config, keyStore *KeyStore) (Signer, error) {
+	if url := config.SignerURL(); url != "" {
+		return NewRemoteSigner(url)
+	}
+	return keyStore, nil
+}
@@ -0,0 +1,172 @@
+package store_test
+
+import (
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ethSignerStandIn serves the eth namespace of a remote signer, signing
+// transactions as signAs regardless of the requested account.
+type ethSignerStandIn struct {
+	keyStore *store.KeyStore
+	signAs   accounts.Account
+}
+
+func (s *ethSignerStandIn) Accounts() []common.Address {
+	return []common.Address{s.signAs.Address}
+}
+
+func (s *ethSignerStandIn) SignTransaction(args store.RemoteSignTxArgs) (store.RemoteSignTxResult, error) {
+	tx := types.NewTransaction(
+		uint64(args.Nonce),
+		*args.To,
+		args.Value.ToInt(),
+		uint64(args.Gas),
+		args.GasPrice.ToInt(),
+		args.Data,
+	)
+	signed, err := s.keyStore.KeyStore.SignTx(s.signAs, tx, args.ChainID.ToInt())
+	if err != nil {
+		return store.RemoteSignTxResult{}, err
+	}
+	raw, err := rlp.EncodeToBytes(signed)
+	return store.RemoteSignTxResult{Raw: raw}, err
+}
+
+// accountSignerStandIn serves the account namespace of a remote signer.
+type accountSignerStandIn struct {
+	keyStore *store.KeyStore
+}
+
+func (s *accountSignerStandIn) SignHash(address common.Address, hash hexutil.Bytes) (hexutil.Bytes, error) {
+	return s.keyStore.SignHash(accounts.Account{Address: address}, hash)
+}
+
+func newRemoteSignerStandIn(t *testing.T, keyStore *store.KeyStore, signAs accounts.Account) (*store.RemoteSigner, func()) {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &ethSignerStandIn{keyStore, signAs}))
+	require.NoError(t, server.RegisterName("account", &accountSignerStandIn{keyStore}))
+	ts := httptest.NewServer(server)
+
+	signer, err := store.NewRemoteSigner(ts.URL)
+	require.NoError(t, err)
+	return signer, func() {
+		ts.Close()
+		server.Stop()
+	}
+}
+
+func TestRemoteSigner_SigningAccounts(t *testing.T) {
+	t.Parallel()
+	s, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	account, err := s.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+	signer, cleanupSigner := newRemoteSignerStandIn(t, s.KeyStore, account)
+	defer cleanupSigner()
+
+	accts, err := signer.SigningAccounts()
+	require.NoError(t, err)
+	require.Len(t, accts, 1)
+	assert.Equal(t, account.Address, accts[0].Address)
+
+	first, err := signer.GetFirstAccount()
+	require.NoError(t, err)
+	assert.Equal(t, account.Address, first.Address)
+}
+
+func TestRemoteSigner_SignTx(t *testing.T) {
+	t.Parallel()
+	s, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	account, err := s.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+	other, err := s.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+
+	tx := types.NewTransaction(7, cltest.NewAddress(), big.NewInt(1), 21000, big.NewInt(20), []byte{1, 2})
+
+	signer, cleanupSigner := newRemoteSignerStandIn(t, s.KeyStore, account)
+	defer cleanupSigner()
+	signed, err := signer.SignTx(account, tx, 3)
+	require.NoError(t, err)
+	local, err := s.KeyStore.SignTx(account, tx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, local.Hash(), signed.Hash())
+
+	impostor, cleanupImpostor := newRemoteSignerStandIn(t, s.KeyStore, other)
+	defer cleanupImpostor()
+	_, err = impostor.SignTx(account, tx, 3)
+	assert.Error(t, err, "should reject a transaction signed by another account")
+}
+
+func TestRemoteSigner_Sign(t *testing.T) {
+	t.Parallel()
+	s, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	account, err := s.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+	signer, cleanupSigner := newRemoteSignerStandIn(t, s.KeyStore, account)
+	defer cleanupSigner()
+
+	input := []byte("service agreement")
+	remote, err := signer.SignWith(account, input)
+	require.NoError(t, err)
+	local, err := s.KeyStore.SignWith(account, input)
+	require.NoError(t, err)
+	assert.Equal(t, local, remote, "remote and keystore signatures should match")
+}
+
+func TestAccountSigner_Sign(t *testing.T) {
+	t.Parallel()
+	s, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	first, err := s.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+	second, err := s.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+
+	input := []byte("service agreement")
+	signer := store.AccountSigner{Signer: s.KeyStore, Account: second}
+	assert.Equal(t, second.Address, signer.Address())
+	signature, err := signer.Sign(input)
+	require.NoError(t, err)
+
+	expected, err := s.KeyStore.SignWith(second, input)
+	require.NoError(t, err)
+	assert.Equal(t, expected, signature)
+	other, err := s.KeyStore.SignWith(first, input)
+	require.NoError(t, err)
+	assert.NotEqual(t, other, signature)
+}
+
+func TestNewSigner(t *testing.T) {
+	t.Parallel()
+	s, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	signer, err := store.NewSigner(s.Config, s.KeyStore)
+	require.NoError(t, err)
+	assert.Equal(t, s.KeyStore, signer)
+
+	s.Config.Set("SIGNER_URL", "http://localhost:8550")
+	signer, err = store.NewSigner(s.Config, s.KeyStore)
+	require.NoError(t, err)
+	assert.IsType(t, &store.RemoteSigner{}, signer)
+}
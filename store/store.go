@@ -6,10 +6,11 @@ import (
 	"fmt"
 	"net/url"
 	"os"
-	"path"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store/migrations"
@@ -27,7 +28,17 @@ type Store struct {
 	KeyStore   *KeyStore
 	RunChannel RunChannel
 	TxManager  TxManager
-	closed     bool
+	// Signer signs with the accounts in KeyStore, unless a remote signer is
+	// configured.
+	Signer Signer
+	// RunNotifier is told about every JobRun persisted while being processed.
+	RunNotifier JobRunNotifier
+	// Events streams changes to runs, heads and transactions to API clients.
+	Events *EventBroadcaster
+	// Balances holds the ETH balances of the node's accounts as of the last
+	// head.
+	Balances *BalanceTracker
+	closed   bool
 }
 
 type lazyRPCWrapper struct {
@@ -127,24 +138,100 @@ func NewStoreWithDialer(config Config, dialer Dialer) *Store {
 		logger.Fatal(fmt.Sprintf("Unable to dial ETH RPC port: %+v", err))
 	}
 	keyStore := NewKeyStore(config.KeysDir())
+	signer, err := NewSigner(config, keyStore)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Unable to initialize signer: %+v", err))
+	}
+	events := NewEventBroadcaster()
+	txManager := NewEthTxManager(&EthClient{ethrpc}, config, signer, orm)
+	txManager.Events = events
 
 	store := &Store{
-		Clock:      Clock{},
-		Config:     config,
-		KeyStore:   keyStore,
-		ORM:        orm,
-		RunChannel: NewQueuedRunChannel(),
-		TxManager:  NewEthTxManager(&EthClient{ethrpc}, config, keyStore, orm),
+		Clock:       Clock{},
+		Config:      config,
+		KeyStore:    keyStore,
+		Signer:      signer,
+		ORM:         orm,
+		RunChannel:  NewQueuedRunChannel(),
+		TxManager:   txManager,
+		RunNotifier: NullJobRunNotifier{},
+		Events:      events,
+		Balances:    NewBalanceTracker(),
 	}
 	return store
 }
 
 // Start initiates all of Store's dependencies including the TxManager.
 func (s *Store) Start() error {
-	s.TxManager.Register(s.KeyStore.Accounts())
+	return s.RegisterSigningAccounts()
+}
+
+// RegisterSigningAccounts hands the signer's accounts to the TxManager, so
+// that accounts added or removed since it was last called are picked up.
+func (s *Store) RegisterSigningAccounts() error {
+	accts, err := s.Signer.SigningAccounts()
+	if err != nil {
+		return err
+	}
+	s.TxManager.Register(accts)
 	return nil
 }
 
+// SigningAccount returns the account service agreements are signed with: the
+// account last rotated to, otherwise the configured SIGNING_ACCOUNT, and
+// otherwise the signer's first account.
+func (s *Store) SigningAccount() (accounts.Account, error) {
+	address, err := s.ORM.SigningAddress()
+	if err != nil {
+		return accounts.Account{}, err
+	}
+	if address == nil {
+		configured := s.Config.SigningAccount()
+		if configured == "" {
+			return s.Signer.GetFirstAccount()
+		} else if !common.IsHexAddress(configured) {
+			return accounts.Account{}, fmt.Errorf("invalid signing account %s", configured)
+		}
+		a := common.HexToAddress(configured)
+		address = &a
+	}
+	return s.findSigningAccount(*address)
+}
+
+// RotateSigningAccount makes the account with the address sign all new
+// service agreements. Agreements signed earlier keep their signing address,
+// so the old key should be kept until they have expired.
+func (s *Store) RotateSigningAccount(address common.Address) (accounts.Account, error) {
+	account, err := s.findSigningAccount(address)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+	return account, s.ORM.SetSigningAddress(account.Address)
+}
+
+// ServiceAgreementSigner returns a signer for new service agreements using
+// the signing account.
+func (s *Store) ServiceAgreementSigner() (AccountSigner, error) {
+	account, err := s.SigningAccount()
+	if err != nil {
+		return AccountSigner{}, err
+	}
+	return AccountSigner{Signer: s.Signer, Account: account}, nil
+}
+
+func (s *Store) findSigningAccount(address common.Address) (accounts.Account, error) {
+	accts, err := s.Signer.SigningAccounts()
+	if err != nil {
+		return accounts.Account{}, err
+	}
+	for _, account := range accts {
+		if account.Address == address {
+			return account, nil
+		}
+	}
+	return accounts.Account{}, fmt.Errorf("signing account %s is not held by the signer", address.Hex())
+}
+
 // Close shuts down all of the working parts of the store.
 func (s *Store) Close() error {
 	s.RunChannel.Close()
@@ -178,9 +265,12 @@ func (Clock) After(d time.Duration) <-chan time.Time {
 }
 
 func initializeORM(config Config) (*orm.ORM, error) {
-	path := path.Join(config.RootDir(), "db.bolt")
+	path := config.DatabasePath()
 	duration := config.DatabaseTimeout()
 	logger.Infof("Waiting %s for lock on db file %s", friendlyDuration(duration), path)
+	if err := orm.CompactIfRequested(path, duration); err != nil {
+		logger.Errorw("Unable to compact database, continuing with it uncompacted", "path", path, "error", err)
+	}
 	orm, err := orm.NewORM(path, duration)
 	if err != nil {
 		return nil, err
@@ -197,6 +287,19 @@ func friendlyDuration(duration time.Duration) string {
 	return fmt.Sprintf("%v", duration)
 }
 
+// JobRunNotifier is informed of a JobRun's state whenever it is saved during
+// processing, so that observers outside of the job runner can react to status
+// changes.
+type JobRunNotifier interface {
+	Notify(run models.JobRun)
+}
+
+// NullJobRunNotifier discards all notifications.
+type NullJobRunNotifier struct{}
+
+// Notify does nothing.
+func (NullJobRunNotifier) Notify(models.JobRun) {}
+
 // RunRequest is the type that the RunChannel uses to package all the necessary
 // pieces to execute a Job Run.
 type RunRequest struct {
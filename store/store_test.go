@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink/internal/mocks"
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStore_Start(t *testing.T) {
@@ -24,6 +25,52 @@ func TestStore_Start(t *testing.T) {
 	assert.NoError(t, store.Start())
 }
 
+func TestStore_SigningAccount(t *testing.T) {
+	t.Parallel()
+
+	s, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	_, err := s.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+	_, err = s.KeyStore.NewAccount(correctPassphrase)
+	require.NoError(t, err)
+	first, err := s.KeyStore.GetFirstAccount()
+	require.NoError(t, err)
+	second := s.KeyStore.Accounts()[1]
+
+	account, err := s.SigningAccount()
+	require.NoError(t, err)
+	assert.Equal(t, first.Address, account.Address)
+
+	s.Config.Set("SIGNING_ACCOUNT", second.Address.Hex())
+	account, err = s.SigningAccount()
+	require.NoError(t, err)
+	assert.Equal(t, second.Address, account.Address)
+
+	s.Config.Set("SIGNING_ACCOUNT", cltest.NewAddress().Hex())
+	_, err = s.SigningAccount()
+	assert.Error(t, err, "should not sign with an account the signer does not hold")
+
+	s.Config.Set("SIGNING_ACCOUNT", "notanaddress")
+	_, err = s.SigningAccount()
+	assert.Error(t, err)
+
+	_, err = s.RotateSigningAccount(cltest.NewAddress())
+	assert.Error(t, err)
+	account, err = s.RotateSigningAccount(first.Address)
+	require.NoError(t, err)
+	assert.Equal(t, first.Address, account.Address)
+
+	account, err = s.SigningAccount()
+	require.NoError(t, err)
+	assert.Equal(t, first.Address, account.Address, "rotation should take precedence over config")
+
+	signer, err := s.ServiceAgreementSigner()
+	require.NoError(t, err)
+	assert.Equal(t, first.Address, signer.Address())
+}
+
 func TestStore_Close(t *testing.T) {
 	t.Parallel()
 
@@ -42,6 +42,7 @@ type TxManager interface {
 	WithdrawLINK(wr models.WithdrawalRequest) (common.Hash, error)
 	GetLINKBalance(address common.Address) (*assets.Link, error)
 	NextActiveAccount() *ManagedAccount
+	GetAvailableAccount(from common.Address) *ManagedAccount
 
 	GetEthBalance(address common.Address) (*assets.Eth, error)
 	SubscribeToNewHeads(channel chan<- models.BlockHeader) (models.EthSubscription, error)
@@ -52,11 +53,11 @@ type TxManager interface {
 
 //go:generate mockgen -package=mocks -destination=../internal/mocks/tx_manager_mocks.go github.com/smartcontractkit/chainlink/store TxManager
 
-// EthTxManager contains fields for the Ethereum client, the KeyStore,
+// EthTxManager contains fields for the Ethereum client, the Signer,
 // the local Config for the application, and the database.
 type EthTxManager struct {
 	*EthClient
-	keyStore            *KeyStore
+	signer              Signer
 	config              Config
 	orm                 *orm.ORM
 	registeredAccounts  []accounts.Account
@@ -64,15 +65,17 @@ type EthTxManager struct {
 	availableAccountIdx int
 	accountsMutex       *sync.Mutex
 	connected           *abool.AtomicBool
+	// Events, when set, is told about created and confirmed tx attempts.
+	Events *EventBroadcaster
 }
 
 // NewEthTxManager constructs an EthTxManager using the passed variables and
 // initializing internal variables.
-func NewEthTxManager(ethClient *EthClient, config Config, keyStore *KeyStore, orm *orm.ORM) *EthTxManager {
+func NewEthTxManager(ethClient *EthClient, config Config, signer Signer, orm *orm.ORM) *EthTxManager {
 	return &EthTxManager{
 		EthClient:     ethClient,
 		config:        config,
-		keyStore:      keyStore,
+		signer:        signer,
 		orm:           orm,
 		accountsMutex: &sync.Mutex{},
 		connected:     abool.New(),
@@ -80,7 +83,8 @@ func NewEthTxManager(ethClient *EthClient, config Config, keyStore *KeyStore, or
 }
 
 // Register activates accounts for outgoing transactions and client side
-// nonce management.
+// nonce management. Once connected, newly registered accounts are activated
+// straight away and accounts missing from accts are no longer used.
 func (txm *EthTxManager) Register(accts []accounts.Account) {
 	txm.accountsMutex.Lock()
 	defer txm.accountsMutex.Unlock()
@@ -88,6 +92,12 @@ func (txm *EthTxManager) Register(accts []accounts.Account) {
 	cp := make([]accounts.Account, len(accts))
 	copy(cp, accts)
 	txm.registeredAccounts = cp
+
+	if txm.Connected() {
+		if err := txm.activateRegisteredAccounts(); err != nil {
+			logger.Errorw("Unable to activate registered accounts", "error", err)
+		}
+	}
 }
 
 // Connected returns a bool indicating whether or not it is connected.
@@ -102,16 +112,33 @@ func (txm *EthTxManager) Connect(bn *models.IndexableBlockNumber) error {
 	defer txm.accountsMutex.Unlock()
 
 	txm.availableAccounts = []*ManagedAccount{}
+	merr := txm.activateRegisteredAccounts()
+	txm.connected.Set()
+	return merr
+}
+
+// activateRegisteredAccounts makes the registered accounts available for
+// transactions, keeping the nonces of accounts that are already available.
+// The caller must hold accountsMutex.
+func (txm *EthTxManager) activateRegisteredAccounts() error {
+	available := []*ManagedAccount{}
 	var merr error
 	for _, a := range txm.registeredAccounts {
+		if ma := txm.findAvailableAccount(a.Address); ma != nil {
+			available = append(available, ma)
+			continue
+		}
 		ma, err := txm.activateAccount(a)
 		merr = multierr.Append(merr, err)
 		if err == nil {
-			txm.availableAccounts = append(txm.availableAccounts, ma)
+			available = append(available, ma)
 		}
 	}
 
-	txm.connected.Set()
+	txm.availableAccounts = available
+	if txm.availableAccountIdx >= len(available) {
+		txm.availableAccountIdx = 0
+	}
 	return merr
 }
 
@@ -374,7 +401,7 @@ func (txm *EthTxManager) createAttempt(
 		return nil, fmt.Errorf("Unable to locate %v as an available account in EthTxManager. Has TxManager been started or has the address been removed?", tx.From.Hex())
 	}
 	etx := tx.EthTx(gasPriceWei)
-	etx, err := txm.keyStore.SignTx(ma.Account, etx, txm.config.ChainID())
+	etx, err := txm.signer.SignTx(ma.Account, etx, txm.config.ChainID())
 	if err != nil {
 		return nil, err
 	}
@@ -383,6 +410,7 @@ func (txm *EthTxManager) createAttempt(
 	if err != nil {
 		return nil, err
 	}
+	txm.Events.PublishTxAttempt(*a)
 	return a, txm.sendTransaction(etx)
 }
 
@@ -454,6 +482,7 @@ func (txm *EthTxManager) handleConfirmed(
 	if err := txm.orm.ConfirmTx(tx, txat); err != nil {
 		return nil, err
 	}
+	txm.Events.PublishTxAttempt(*txat)
 
 	ethBalance, linkBalance, balanceErr := txm.GetETHAndLINKBalances(tx.From)
 	logger.Infow(
@@ -525,6 +554,16 @@ func (txm *EthTxManager) getAccount(from common.Address) *ManagedAccount {
 	txm.accountsMutex.Lock()
 	defer txm.accountsMutex.Unlock()
 
+	return txm.findAvailableAccount(from)
+}
+
+// GetAvailableAccount returns the managed account for the address, or nil if
+// it is not available for transactions.
+func (txm *EthTxManager) GetAvailableAccount(from common.Address) *ManagedAccount {
+	return txm.getAccount(from)
+}
+
+func (txm *EthTxManager) findAvailableAccount(from common.Address) *ManagedAccount {
 	for _, a := range txm.availableAccounts {
 		if a.Address == from {
 			return a
@@ -511,6 +511,44 @@ func TestTxManager_NextActiveAccount_RoundRobin(t *testing.T) {
 	assert.Equal(t, a0, a2)
 }
 
+func TestTxManager_Register_WhileConnected(t *testing.T) {
+	t.Parallel()
+
+	ethMock := &cltest.EthMock{}
+	txm := store.NewEthTxManager(
+		&strpkg.EthClient{CallerSubscriber: ethMock},
+		store.NewConfig(),
+		nil,
+		nil,
+	)
+
+	a1 := accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca001")}
+	a2 := accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca002")}
+
+	ethMock.Register("eth_getTransactionCount", `0x1D0`)
+	txm.Register([]accounts.Account{a1})
+	require.NoError(t, txm.Connect(cltest.IndexableBlockNumber(1)))
+	ethMock.EventuallyAllCalled(t)
+
+	ma1 := txm.GetAvailableAccount(a1.Address)
+	require.NotNil(t, ma1)
+	require.NoError(t, ma1.GetAndIncrementNonce(func(uint64) error { return nil }))
+
+	ethMock.Register("eth_getTransactionCount", `0x2D0`)
+	txm.Register([]accounts.Account{a1, a2})
+	ethMock.EventuallyAllCalled(t)
+
+	assert.Equal(t, uint64(0x1d1), txm.GetAvailableAccount(a1.Address).GetNonce(), "should keep the managed nonce")
+	ma2 := txm.GetAvailableAccount(a2.Address)
+	require.NotNil(t, ma2)
+	assert.Equal(t, uint64(0x2d0), ma2.GetNonce())
+
+	txm.Register([]accounts.Account{a2})
+	assert.Nil(t, txm.GetAvailableAccount(a1.Address))
+	assert.Equal(t, ma2, txm.NextActiveAccount())
+	assert.Equal(t, ma2, txm.NextActiveAccount())
+}
+
 func TestTxManager_ReloadNonce(t *testing.T) {
 	t.Parallel()
 
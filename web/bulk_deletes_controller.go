@@ -24,7 +24,7 @@ func (c *BulkDeletesController) Create(ctx *gin.Context) {
 		ctx.AbortWithError(422, err)
 	} else if task, err := models.NewBulkDeleteRunTask(request); err != nil {
 		ctx.AbortWithError(422, err)
-	} else if err := c.App.GetStore().ORM.DB.Save(task); err != nil {
+	} else if err := c.App.GetStore().SaveBulkDeleteRunTask(task); err != nil {
 		ctx.AbortWithError(500, err)
 	} else if doc, err := jsonapi.Marshal(task); err != nil {
 		ctx.AbortWithError(500, err)
@@ -36,13 +36,30 @@ func (c *BulkDeletesController) Create(ctx *gin.Context) {
 
 // Show returns the details of a BulkDeleteTask.
 // Example:
-//  "<application>/bulk_delete_runs/:RunID"
+//  "<application>/bulk_delete_runs/:taskID"
 func (c *BulkDeletesController) Show(ctx *gin.Context) {
 	id := ctx.Param("taskID")
-	task := models.BulkDeleteRunTask{}
+	if task, err := c.App.GetStore().FindBulkDeleteRunTask(id); err == orm.ErrorNotFound {
+		ctx.AbortWithError(404, errors.New("Bulk delete task not found"))
+	} else if err != nil {
+		ctx.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(&task); err != nil {
+		ctx.AbortWithError(500, err)
+	} else {
+		ctx.Data(200, MediaType, doc)
+	}
+}
 
-	if err := c.App.GetStore().ORM.DB.One("ID", id, &task); err == orm.ErrorNotFound {
+// Destroy cancels a BulkDeleteTask that is still in progress. Runs it has
+// already deleted are not restored.
+// Example:
+//  "<application>/bulk_delete_runs/:taskID"
+func (c *BulkDeletesController) Destroy(ctx *gin.Context) {
+	id := ctx.Param("taskID")
+	if task, err := c.App.GetStore().CancelBulkDeleteRunTask(id); err == orm.ErrorNotFound {
 		ctx.AbortWithError(404, errors.New("Bulk delete task not found"))
+	} else if err == orm.ErrorBulkTaskFinished {
+		ctx.AbortWithError(409, err)
 	} else if err != nil {
 		ctx.AbortWithError(500, err)
 	} else if doc, err := jsonapi.Marshal(&task); err != nil {
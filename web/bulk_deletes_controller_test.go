@@ -0,0 +1,45 @@
+package web_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkDeletesController_Destroy(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	pending, err := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{
+		Status:        []models.RunStatus{models.RunStatusCompleted},
+		UpdatedBefore: time.Now(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, app.Store.SaveBulkDeleteRunTask(pending))
+
+	resp, cleanup := client.Delete("/v2/bulk_delete_runs/" + pending.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	var task models.BulkDeleteRunTask
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &task))
+	assert.Equal(t, pending.ID, task.ID)
+	assert.Equal(t, models.BulkTaskStatusCancelled, task.Status)
+
+	found, err := app.Store.FindBulkDeleteRunTask(pending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkTaskStatusCancelled, found.Status)
+
+	resp, cleanup = client.Delete("/v2/bulk_delete_runs/" + pending.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 409)
+
+	resp, cleanup = client.Delete("/v2/bulk_delete_runs/bogus")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 404)
+}
@@ -0,0 +1,56 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+)
+
+// CompactionController schedules compaction of the node's database, which
+// takes place the next time the node starts. The file is not compacted while
+// the node runs, so it must be restarted for a request to take effect.
+type CompactionController struct {
+	App services.Application
+}
+
+// Show returns whether compaction has been requested, and the size of the
+// database file.
+// Example:
+//  "<application>/compaction"
+func (cc *CompactionController) Show(c *gin.Context) {
+	cc.render(c, 200)
+}
+
+// Create requests that the database be compacted the next time the node
+// starts.
+// Example:
+//  "<application>/compaction"
+func (cc *CompactionController) Create(c *gin.Context) {
+	if err := cc.App.GetStore().RequestCompaction(); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		cc.render(c, 201)
+	}
+}
+
+// Destroy withdraws a request to compact the database.
+// Example:
+//  "<application>/compaction"
+func (cc *CompactionController) Destroy(c *gin.Context) {
+	if err := cc.App.GetStore().CancelCompaction(); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		cc.render(c, 200)
+	}
+}
+
+func (cc *CompactionController) render(c *gin.Context, status int) {
+	if compaction, err := presenters.NewCompaction(cc.App.GetStore().ORM); err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(compaction); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(status, MediaType, doc)
+	}
+}
@@ -0,0 +1,39 @@
+package web_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactionController(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/compaction")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	var compaction presenters.Compaction
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &compaction))
+	assert.False(t, compaction.Requested)
+	assert.True(t, compaction.DatabaseSize > 0)
+
+	resp, cleanup = client.Post("/v2/compaction", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 201)
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &compaction))
+	assert.True(t, compaction.Requested)
+	assert.True(t, app.Store.CompactionRequested())
+
+	resp, cleanup = client.Delete("/v2/compaction")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &compaction))
+	assert.False(t, compaction.Requested)
+	assert.False(t, app.Store.CompactionRequested())
+}
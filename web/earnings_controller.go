@@ -0,0 +1,49 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/orm"
+)
+
+// EarningsController reports the LINK earned by jobs against the ETH spent
+// on gas fulfilling them.
+type EarningsController struct {
+	App services.Application
+}
+
+// Index returns the earnings of every job on the node along with the node's
+// totals.
+// Example:
+//  "<application>/earnings"
+func (ec *EarningsController) Index(c *gin.Context) {
+	if summary, err := services.EarningsSummary(ec.App.GetStore().ORM); err != nil {
+		c.AbortWithError(500, fmt.Errorf("error calculating earnings: %+v", err))
+	} else if doc, err := jsonapi.Marshal(summary); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// Show returns the earnings of each run of a JobSpec along with its totals.
+// Example:
+//  "<application>/specs/:SpecID/earnings"
+func (ec *EarningsController) Show(c *gin.Context) {
+	store := ec.App.GetStore()
+	if job, err := store.FindJob(c.Param("SpecID")); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("Job not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if earnings, err := services.JobEarnings(store.ORM, job.ID); err != nil {
+		c.AbortWithError(500, fmt.Errorf("error calculating earnings: %+v", err))
+	} else if doc, err := jsonapi.Marshal(earnings); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
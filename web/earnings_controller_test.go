@@ -0,0 +1,76 @@
+package web_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEarningsController_Index(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&job))
+	run := job.NewRun(initr)
+	run.Status = models.RunStatusCompleted
+	run.Result.Amount = assets.NewLink(4)
+	require.NoError(t, app.Store.SaveJobRun(&run))
+
+	resp, cleanup := client.Get("/v2/earnings")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var summary presenters.EarningsSummary
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &summary))
+	assert.Equal(t, 1, summary.RunCount)
+	assert.Equal(t, assets.NewLink(4), summary.LinkEarned)
+	require.Len(t, summary.Jobs, 1)
+	assert.Equal(t, job.ID, summary.Jobs[0].JobID)
+}
+
+func TestEarningsController_Show(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&job))
+	run := job.NewRun(initr)
+	run.Status = models.RunStatusCompleted
+	run.Result.Amount = assets.NewLink(4)
+	require.NoError(t, app.Store.SaveJobRun(&run))
+
+	resp, cleanup := client.Get("/v2/specs/" + job.ID + "/earnings")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var earnings presenters.JobEarnings
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &earnings))
+	assert.Equal(t, job.ID, earnings.JobID)
+	assert.Equal(t, assets.NewLink(4), earnings.LinkEarned)
+	require.Len(t, earnings.Runs, 1)
+	assert.Equal(t, run.ID, earnings.Runs[0].RunID)
+}
+
+func TestEarningsController_Show_NotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/specs/garbage/earnings")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 404)
+}
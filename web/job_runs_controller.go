@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/services"
@@ -20,31 +23,31 @@ type JobRunsController struct {
 	App services.Application
 }
 
-// Index returns paginated JobRuns for a given JobSpec
+// Index returns paginated JobRuns, optionally filtered by job, status,
+// initiator type, task type, requester and time range. Multiple values can be
+// passed as a comma separated list, and times are formatted as RFC3339.
 // Example:
-//  "<application>/runs?jobSpecId=:jobSpecId&size=1&page=2"
+//  "<application>/runs?jobSpecId=:jobSpecId&status=errored,completed&sort=-createdAt&size=1&page=2"
 func (jrc *JobRunsController) Index(c *gin.Context) {
-	id := c.Query("jobSpecId")
 	size, page, offset, err := ParsePaginatedRequest(c.Query("size"), c.Query("page"))
 	if err != nil {
 		c.AbortWithError(422, err)
 		return
 	}
 
-	order := orm.Ascending
-	if c.Query("sort") == "-createdAt" {
-		order = orm.Descending
+	filter, err := parseJobRunFilter(c)
+	if err != nil {
+		publicError(c, 422, err)
+		return
 	}
 
-	store := jrc.App.GetStore()
-	var runs []models.JobRun
-	var count int
-	if id == "" {
-		runs, count, err = store.JobRunsSorted(order, offset, size)
-	} else {
-		runs, count, err = store.JobRunsSortedFor(id, order, offset, size)
+	sortField, order, err := parseJobRunSort(c.Query("sort"))
+	if err != nil {
+		publicError(c, 422, err)
+		return
 	}
 
+	runs, count, err := jrc.App.GetStore().FilteredJobRuns(filter, sortField, order, offset, size)
 	if err == orm.ErrorNotFound {
 		c.Data(404, MediaType, emptyJSON)
 	} else if err != nil {
@@ -56,6 +59,90 @@ func (jrc *JobRunsController) Index(c *gin.Context) {
 	}
 }
 
+var jobRunSortFields = map[string]string{
+	"createdAt": "CreatedAt",
+	"updatedAt": "UpdatedAt",
+}
+
+func parseJobRunSort(sort string) (string, orm.SortType, error) {
+	if sort == "" {
+		return "CreatedAt", orm.Ascending, nil
+	}
+
+	order := orm.Ascending
+	if strings.HasPrefix(sort, "-") {
+		order = orm.Descending
+		sort = sort[1:]
+	}
+
+	field, ok := jobRunSortFields[sort]
+	if !ok {
+		return "", order, fmt.Errorf("cannot sort runs by %s", sort)
+	}
+	return field, order, nil
+}
+
+func parseJobRunFilter(c *gin.Context) (orm.JobRunFilter, error) {
+	filter := orm.JobRunFilter{
+		JobID:          c.Query("jobSpecId"),
+		InitiatorTypes: queryList(c, "initiatorType"),
+		TaskTypes:      queryList(c, "taskType"),
+	}
+
+	for _, val := range queryList(c, "status") {
+		status, err := models.NewRunStatus(val)
+		if err != nil {
+			return filter, err
+		}
+		filter.Statuses = append(filter.Statuses, status)
+	}
+
+	if requester := c.Query("requester"); requester != "" {
+		if !common.IsHexAddress(requester) {
+			return filter, fmt.Errorf("%s is not a valid requester address", requester)
+		}
+		address := common.HexToAddress(requester)
+		filter.Requester = &address
+	}
+
+	times := []struct {
+		param string
+		dest  *time.Time
+	}{
+		{"createdAfter", &filter.CreatedAfter},
+		{"createdBefore", &filter.CreatedBefore},
+		{"completedAfter", &filter.CompletedAfter},
+		{"completedBefore", &filter.CompletedBefore},
+	}
+	for _, t := range times {
+		val := c.Query(t.param)
+		if val == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, val)
+		if err != nil {
+			return filter, fmt.Errorf("%s must be an RFC3339 time: %v", t.param, err)
+		}
+		*t.dest = parsed
+	}
+
+	return filter, nil
+}
+
+// queryList returns the lower cased values of a query parameter, which can be
+// repeated or passed as a comma separated list.
+func queryList(c *gin.Context, key string) []string {
+	var values []string
+	for _, param := range c.QueryArray(key) {
+		for _, val := range strings.Split(param, ",") {
+			if val = strings.TrimSpace(val); val != "" {
+				values = append(values, strings.ToLower(val))
+			}
+		}
+	}
+	return values
+}
+
 // Create starts a new Run for the requested JobSpec.
 // Example:
 //  "<application>/specs/:SpecID/runs"
@@ -68,6 +155,8 @@ func (jrc *JobRunsController) Create(c *gin.Context) {
 		c.AbortWithError(500, err)
 	} else if !j.WebAuthorized() {
 		c.AbortWithError(403, errors.New("Job not available on web API, recreate with web initiator"))
+	} else if j.Archived() {
+		c.AbortWithError(410, errors.New("Job has been archived"))
 	} else if data, err := getRunData(c); err != nil {
 		c.AbortWithError(500, err)
 	} else if jr, err := services.ExecuteJob(j, j.InitiatorsFor(models.InitiatorWeb)[0], models.RunResult{Data: data}, nil, jrc.App.GetStore()); err != nil {
@@ -103,6 +192,44 @@ func (jrc *JobRunsController) Show(c *gin.Context) {
 	}
 }
 
+// Export streams completed JobRuns as CSV or newline delimited JSON,
+// optionally restricted to runs completed within an RFC3339 time range.
+// Example:
+//  "<application>/runs/export?format=ndjson&from=2018-01-01T00:00:00Z&to=2018-02-01T00:00:00Z"
+func (jrc *JobRunsController) Export(c *gin.Context) {
+	format, err := services.NewRunExportFormat(c.Query("format"))
+	if err != nil {
+		publicError(c, 422, err)
+		return
+	}
+
+	var from, to time.Time
+	times := []struct {
+		param string
+		dest  *time.Time
+	}{
+		{"from", &from},
+		{"to", &to},
+	}
+	for _, t := range times {
+		val := c.Query(t.param)
+		if val == "" {
+			continue
+		}
+		if *t.dest, err = time.Parse(time.RFC3339, val); err != nil {
+			publicError(c, 422, fmt.Errorf("%s must be an RFC3339 time: %v", t.param, err))
+			return
+		}
+	}
+
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="runs.%s"`, format))
+	c.Status(200)
+	if err := services.ExportRuns(jrc.App.GetStore().ORM, c.Writer, format, from, to); err != nil {
+		c.Error(fmt.Errorf("error exporting runs: %+v", err))
+	}
+}
+
 // Update allows external adapters to resume a JobRun, reporting the result of
 // the task and marking it no longer pending.
 // Example:
@@ -1,7 +1,9 @@
 package web_test
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -15,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/web"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v3"
 )
 
 type JobRunsJSON struct {
@@ -102,6 +105,70 @@ func TestJobRunsController_Index(t *testing.T) {
 	assert.Equal(t, runA.ID, allJobRuns[2].ID, "expected runs ordered by created at descending")
 }
 
+func TestJobRunsController_Index_Filtered(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	runA, runB, runC := setupJobRunsControllerIndex(t, app)
+	runB.Status = models.RunStatusErrored
+	require.NoError(t, app.Store.SaveJobRun(runB))
+	requester := cltest.NewAddress()
+	runC.Status = models.RunStatusCompleted
+	runC.Requester = &requester
+	require.NoError(t, app.Store.SaveJobRun(runC))
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"statuses", "status=errored,completed", []string{runB.ID, runC.ID}},
+		{"repeated statuses", "status=errored&status=completed&sort=-createdAt", []string{runC.ID, runB.ID}},
+		{"job and status", "jobSpecId=" + runA.JobID + "&status=errored", []string{runB.ID}},
+		{"initiator type", "initiatorType=web&status=completed", []string{runC.ID}},
+		{"task type", "taskType=ethtx", []string{}},
+		{"requester", "requester=" + requester.Hex(), []string{runC.ID}},
+		{"created before", "createdBefore=" + runB.CreatedAt.UTC().Format(time.RFC3339Nano), []string{runA.ID}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Get("/v2/runs?" + test.query)
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, 200)
+
+			var links jsonapi.Links
+			runs := []models.JobRun{}
+			err := web.ParsePaginatedResponse(cltest.ParseResponseBody(resp), &runs, &links)
+			require.NoError(t, err)
+
+			ids := []string{}
+			for _, run := range runs {
+				ids = append(ids, run.ID)
+			}
+			assert.Equal(t, test.want, ids)
+		})
+	}
+
+	invalid := []string{
+		"status=bogus",
+		"requester=0xnope",
+		"createdAfter=yesterday",
+		"sort=status",
+	}
+	for _, query := range invalid {
+		t.Run(query, func(t *testing.T) {
+			resp, cleanup := client.Get("/v2/runs?" + query)
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, 422)
+		})
+	}
+}
+
 func setupJobRunsControllerIndex(t assert.TestingT, app *cltest.TestApplication) (*models.JobRun, *models.JobRun, *models.JobRun) {
 	j1, initr := cltest.NewJobWithWebInitiator()
 	assert.Nil(t, app.Store.SaveJob(&j1))
@@ -430,3 +497,55 @@ func TestJobRunsController_Show_Unauthenticated(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 401, resp.StatusCode, "Response should be forbidden")
 }
+
+func TestJobRunsController_Export(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&job))
+	january := job.NewRun(initr)
+	january.Status = models.RunStatusCompleted
+	january.CompletedAt = null.TimeFrom(cltest.ParseISO8601("2018-01-15T00:00:00Z"))
+	require.NoError(t, app.Store.SaveJobRun(&january))
+	february := job.NewRun(initr)
+	february.Status = models.RunStatusCompleted
+	february.CompletedAt = null.TimeFrom(cltest.ParseISO8601("2018-02-15T00:00:00Z"))
+	require.NoError(t, app.Store.SaveJobRun(&february))
+	pending := job.NewRun(initr)
+	pending.Status = models.RunStatusPendingBridge
+	require.NoError(t, app.Store.SaveJobRun(&pending))
+
+	resp, cleanup := client.Get("/v2/runs/export?format=ndjson&from=2018-01-01T00:00:00Z&to=2018-02-01T00:00:00Z")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+
+	var ids []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var record struct {
+			RunID string `json:"runId"`
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		ids = append(ids, record.RunID)
+	}
+	assert.Equal(t, []string{january.ID}, ids)
+}
+
+func TestJobRunsController_Export_InvalidParams(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	for _, query := range []string{"format=xml", "from=yesterday", "to=2018-01-01"} {
+		resp, cleanup := client.Get("/v2/runs/export?" + query)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, 422)
+	}
+}
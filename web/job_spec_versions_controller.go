@@ -0,0 +1,104 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+)
+
+// JobSpecVersionsController manages the version history of JobSpecs.
+type JobSpecVersionsController struct {
+	App services.Application
+}
+
+// Index lists every version of a JobSpec, oldest first.
+// Example:
+//  "<application>/specs/:SpecID/versions"
+func (jsvc *JobSpecVersionsController) Index(c *gin.Context) {
+	id := c.Param("SpecID")
+	if _, err := jsvc.App.GetStore().FindJob(id); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("JobSpec not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if versions, err := jsvc.App.GetStore().JobSpecVersions(id); err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(versions); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// Show returns a JobSpec as it was saved at the given version.
+// Example:
+//  "<application>/specs/:SpecID/versions/:Version"
+func (jsvc *JobSpecVersionsController) Show(c *gin.Context) {
+	if version, err := parseJobSpecVersion(c.Param("Version")); err != nil {
+		publicError(c, 422, err)
+	} else if v, err := jsvc.App.GetStore().FindJobSpecVersion(c.Param("SpecID"), version); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("JobSpec version not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(v); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// Diff lists the changes made to a JobSpec up to the given version, from
+// the version passed as from, or the one preceding it. The first version is
+// compared with an empty JobSpec unless from is passed.
+// Example:
+//  "<application>/specs/:SpecID/versions/:Version/diff?from=1"
+func (jsvc *JobSpecVersionsController) Diff(c *gin.Context) {
+	id := c.Param("SpecID")
+	if to, err := jsvc.findVersion(id, c.Param("Version")); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("JobSpec version not found"))
+	} else if err != nil {
+		publicError(c, 422, err)
+	} else if from, err := jsvc.findPreviousVersion(to, c); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("JobSpec version to compare with not found"))
+	} else if err != nil {
+		publicError(c, 422, err)
+	} else if diff, err := presenters.NewJobSpecDiff(from, to); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.JSON(200, diff)
+	}
+}
+
+func (jsvc *JobSpecVersionsController) findVersion(jobID, param string) (models.JobSpecVersion, error) {
+	version, err := parseJobSpecVersion(param)
+	if err != nil {
+		return models.JobSpecVersion{}, err
+	}
+	return jsvc.App.GetStore().FindJobSpecVersion(jobID, version)
+}
+
+// findPreviousVersion returns the version passed as from, or the one
+// preceding to, which is empty when to is the first version.
+func (jsvc *JobSpecVersionsController) findPreviousVersion(to models.JobSpecVersion, c *gin.Context) (models.JobSpecVersion, error) {
+	param, ok := c.GetQuery("from")
+	if !ok && to.Version == 1 {
+		return models.JobSpecVersion{JobID: to.JobID}, nil
+	} else if !ok {
+		param = fmt.Sprint(to.Version - 1)
+	}
+	return jsvc.findVersion(to.JobID, param)
+}
+
+func parseJobSpecVersion(param string) (uint32, error) {
+	version, err := strconv.ParseUint(param, 10, 32)
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("invalid job version %v, must be a positive integer", param)
+	}
+	return uint32(version), nil
+}
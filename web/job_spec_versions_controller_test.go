@@ -0,0 +1,139 @@
+package web_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupJobSpecVersions(t *testing.T, app *cltest.TestApplication) models.JobSpec {
+	j, _ := cltest.NewJobWithWebInitiator()
+	j.Name = "eth-usd"
+	require.NoError(t, app.Store.SaveJob(&j))
+
+	next, err := j.NewVersion([]byte(`{"name":"btc-usd"}`))
+	require.NoError(t, err)
+	require.NoError(t, app.UpdateJob(&next))
+	return next
+}
+
+func TestJobSpecVersionsController_Index(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+	j := setupJobSpecVersions(t, app)
+
+	resp, cleanup := client.Get("/v2/specs/" + j.ID + "/versions")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var versions []models.JobSpecVersion
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &versions))
+	require.Len(t, versions, 2)
+	assert.Equal(t, uint32(1), versions[0].Version)
+	assert.Equal(t, "eth-usd", versions[0].Name)
+	assert.Equal(t, uint32(2), versions[1].Version)
+	assert.Equal(t, "btc-usd", versions[1].Name)
+
+	resp, cleanup = client.Get("/v2/specs/garbage/versions")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 404)
+}
+
+func TestJobSpecVersionsController_Show(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+	j := setupJobSpecVersions(t, app)
+
+	resp, cleanup := client.Get("/v2/specs/" + j.ID + "/versions/1")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var v models.JobSpecVersion
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &v))
+	assert.Equal(t, j.ID, v.JobID)
+	assert.Equal(t, uint32(1), v.Version)
+	assert.Equal(t, "eth-usd", v.Name)
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+	}{
+		{"missing version", "/v2/specs/" + j.ID + "/versions/3", 404},
+		{"missing job", "/v2/specs/garbage/versions/1", 404},
+		{"zero version", "/v2/specs/" + j.ID + "/versions/0", 422},
+		{"invalid version", "/v2/specs/" + j.ID + "/versions/latest", 422},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Get(test.path)
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, test.status)
+		})
+	}
+}
+
+func TestJobSpecVersionsController_Diff(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+	j := setupJobSpecVersions(t, app)
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		want   []presenters.JobSpecChange
+	}{
+		{"previous version", "/versions/2/diff", 200, []presenters.JobSpecChange{
+			{Path: "name", From: "eth-usd", To: "btc-usd"},
+		}},
+		{"reversed", "/versions/1/diff?from=2", 200, []presenters.JobSpecChange{
+			{Path: "name", From: "btc-usd", To: "eth-usd"},
+		}},
+		{"same version", "/versions/2/diff?from=2", 200, []presenters.JobSpecChange{}},
+		{"missing version", "/versions/3/diff", 404, nil},
+		{"missing from", "/versions/2/diff?from=5", 404, nil},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Get("/v2/specs/" + j.ID + test.path)
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, test.status)
+			if test.status != 200 {
+				return
+			}
+
+			var diff presenters.JobSpecDiff
+			require.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &diff))
+			assert.Equal(t, j.ID, diff.JobID)
+			assert.Equal(t, test.want, diff.Changes)
+		})
+	}
+
+	t.Run("first version", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/specs/" + j.ID + "/versions/1/diff")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, 200)
+
+		var diff presenters.JobSpecDiff
+		require.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &diff))
+		assert.Equal(t, uint32(0), diff.From)
+		assert.Equal(t, uint32(1), diff.To)
+		assert.Contains(t, diff.Changes, presenters.JobSpecChange{Path: "name", From: nil, To: "eth-usd"})
+	})
+}
@@ -3,6 +3,7 @@ package web
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/gin-gonic/gin"
 	"github.com/manyminds/api2go/jsonapi"
@@ -17,9 +18,10 @@ type JobSpecsController struct {
 	App services.Application
 }
 
-// Index lists JobSpecs, one page at a time.
+// Index lists JobSpecs, one page at a time, optionally filtered by name and
+// tags. Jobs must have every tag passed, as a comma separated list.
 // Example:
-//  "<application>/specs?size=1&page=2"
+//  "<application>/specs?size=1&page=2&tag=eth,price-feed"
 func (jsc *JobSpecsController) Index(c *gin.Context) {
 	size, page, offset, err := ParsePaginatedRequest(c.Query("size"), c.Query("page"))
 	if err != nil {
@@ -34,9 +36,12 @@ func (jsc *JobSpecsController) Index(c *gin.Context) {
 		order = orm.Ascending
 	}
 
-	if count, err := jsc.App.GetStore().Count(&models.JobSpec{}); err != nil {
-		c.AbortWithError(500, fmt.Errorf("error getting count of JobSpec: %+v", err))
-	} else if jobs, err := jsc.App.GetStore().JobsSorted(order, offset, size); err != nil {
+	filter := orm.JobSpecFilter{
+		Name: c.Query("name"),
+		Tags: queryList(c, "tag"),
+	}
+
+	if jobs, count, err := jsc.App.GetStore().JobsSorted(filter, order, offset, size); err != nil {
 		c.AbortWithError(500, fmt.Errorf("erorr fetching All JobSpecs: %+v", err))
 	} else {
 		pjs := make([]presenters.JobSpec, len(jobs))
@@ -89,6 +94,74 @@ func (jsc *JobSpecsController) Show(c *gin.Context) {
 	}
 }
 
+// Export returns the portable form of a JobSpec, which can be created on
+// another node as is.
+// Example:
+//  "<application>/specs/:SpecID/export"
+func (jsc *JobSpecsController) Export(c *gin.Context) {
+	id := c.Param("SpecID")
+	if j, err := jsc.App.GetStore().FindJob(id); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("JobSpec not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.JSON(200, presenters.NewJobSpecExport(j))
+	}
+}
+
+// Destroy archives a JobSpec, so that it no longer runs. Its runs are kept.
+// Example:
+//  "<application>/specs/:SpecID"
+func (jsc *JobSpecsController) Destroy(c *gin.Context) {
+	id := c.Param("SpecID")
+	if err := jsc.App.ArchiveJob(id); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("JobSpec not found"))
+	} else if err == orm.ErrorJobArchived {
+		publicError(c, 409, err)
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if j, err := jsc.App.GetStore().FindJob(id); err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(presenters.JobSpec{JobSpec: j, Runs: []presenters.JobRun{}}); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// Update saves the fields passed as the next version of a JobSpec, keeping
+// its ID, and starts it in place of the previous version.
+// Example:
+//  "<application>/specs/:SpecID"
+func (jsc *JobSpecsController) Update(c *gin.Context) {
+	id := c.Param("SpecID")
+	if j, err := jsc.App.GetStore().FindJob(id); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("JobSpec not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if j.Archived() {
+		publicError(c, 409, errors.New("JobSpec has been archived"))
+	} else if _, err := jsc.App.GetStore().FindServiceAgreementForJob(id); err == nil {
+		publicError(c, 409, errors.New("JobSpec belongs to a ServiceAgreement and cannot be updated"))
+	} else if err != orm.ErrorNotFound {
+		c.AbortWithError(500, err)
+	} else if patch, err := ioutil.ReadAll(c.Request.Body); err != nil {
+		publicError(c, 400, err)
+	} else if next, err := j.NewVersion(patch); err != nil {
+		publicError(c, 400, err)
+	} else if err := services.ValidateJob(next, jsc.App.GetStore()); err != nil {
+		publicError(c, 400, err)
+	} else if err := jsc.App.UpdateJob(&next); err == orm.ErrorJobVersionConflict || err == orm.ErrorJobArchived {
+		publicError(c, 409, err)
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(presenters.JobSpec{JobSpec: next, Runs: []presenters.JobRun{}}); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
 func marshalSpecFromJSONAPI(j models.JobSpec, runs []models.JobRun) (*jsonapi.Document, error) {
 	pruns := make([]presenters.JobRun, len(runs))
 	for i, r := range runs {
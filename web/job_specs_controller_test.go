@@ -128,6 +128,62 @@ func TestJobSpecsController_Index_sortCreatedAt(t *testing.T) {
 	assert.Equal(t, jobs[1].ID, descJobs[1].ID)
 }
 
+func TestJobSpecsController_Index_filter(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	ethUSD, _ := cltest.NewJobWithWebInitiator()
+	ethUSD.Name = "eth-usd"
+	ethUSD.Tags = []string{"eth", "price-feed"}
+	ethUSD.CreatedAt = models.Time{Time: time.Now().AddDate(0, 0, -2)}
+	require.NoError(t, app.Store.SaveJob(&ethUSD))
+	ethGas, _ := cltest.NewJobWithWebInitiator()
+	ethGas.Tags = []string{"eth"}
+	ethGas.CreatedAt = models.Time{Time: time.Now().AddDate(0, 0, -1)}
+	require.NoError(t, app.Store.SaveJob(&ethGas))
+	untagged, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&untagged))
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"name", "name=eth-usd", []string{ethUSD.ID}},
+		{"one tag", "tag=eth", []string{ethUSD.ID, ethGas.ID}},
+		{"every tag", "tag=eth,PRICE-FEED", []string{ethUSD.ID}},
+		{"repeated tag param", "tag=eth&tag=price-feed", []string{ethUSD.ID}},
+		{"name and tag", "name=eth-usd&tag=gas", []string{}},
+		{"no match", "tag=btc", []string{}},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Get("/v2/specs?sort=createdAt&" + test.query)
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, 200)
+			body := cltest.ParseResponseBody(resp)
+
+			metaCount, err := cltest.ParseJSONAPIResponseMetaCount(body)
+			require.NoError(t, err)
+			assert.Equal(t, len(test.want), metaCount)
+
+			var links jsonapi.Links
+			jobs := []models.JobSpec{}
+			require.NoError(t, web.ParsePaginatedResponse(body, &jobs, &links))
+			ids := []string{}
+			for _, j := range jobs {
+				ids = append(ids, j.ID)
+			}
+			assert.Equal(t, test.want, ids)
+		})
+	}
+}
+
 func setupJobSpecsControllerIndex(app *cltest.TestApplication) (*models.JobSpec, error) {
 	j1, _ := cltest.NewJobWithSchedule("9 9 9 9 6")
 	j1.CreatedAt = models.Time{Time: time.Now().AddDate(0, 0, -1)}
@@ -364,3 +420,171 @@ func TestJobSpecsController_Show_Unauthenticated(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 401, resp.StatusCode, "Response should be forbidden")
 }
+
+func TestJobSpecsController_Export(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Post("/v2/specs", bytes.NewBuffer(cltest.LoadJSON("../internal/fixtures/web/hello_world_named_job.json")))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	var j models.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &j))
+	assert.Equal(t, "hello-world", j.Name)
+
+	resp, cleanup = client.Get("/v2/specs/" + j.ID + "/export")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	exported := cltest.ParseResponseBody(resp)
+	assert.NotContains(t, string(exported), j.ID)
+
+	otherApp, cleanup := cltest.NewApplication()
+	defer cleanup()
+	resp, cleanup = otherApp.NewHTTPClient().Post("/v2/specs", bytes.NewBuffer(exported))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	var created models.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &created))
+	assert.Equal(t, j.Name, created.Name)
+	assert.Equal(t, j.Initiators[0].Type, created.Initiators[0].Type)
+	require.Len(t, created.Tasks, len(j.Tasks))
+	for i, task := range j.Tasks {
+		assert.Equal(t, task.Type, created.Tasks[i].Type)
+		assert.JSONEq(t, task.Params.String(), created.Tasks[i].Params.String())
+	}
+}
+
+func TestJobSpecsController_Export_NotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/specs/garbage/export")
+	defer cleanup()
+	assert.Equal(t, 404, resp.StatusCode, "Response should be not found")
+}
+
+func TestJobSpecsController_Create_DuplicateName(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Post("/v2/specs", bytes.NewBuffer(cltest.LoadJSON("../internal/fixtures/web/hello_world_named_job.json")))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	resp, cleanup = client.Post("/v2/specs", bytes.NewBuffer(cltest.LoadJSON("../internal/fixtures/web/hello_world_named_job.json")))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 400)
+}
+
+func TestJobSpecsController_Destroy(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	j, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&j))
+
+	resp, cleanup := client.Delete("/v2/specs/" + j.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	var archived models.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &archived))
+	assert.True(t, archived.Archived())
+
+	resp, cleanup = client.Post("/v2/specs/"+j.ID+"/runs", &bytes.Buffer{})
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 410)
+
+	resp, cleanup = client.Delete("/v2/specs/" + j.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 409)
+}
+
+func TestJobSpecsController_Update(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	j, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&j))
+
+	body := bytes.NewBufferString(`{"name":"hello-world","tags":["demo"]}`)
+	resp, cleanup := client.Patch("/v2/specs/"+j.ID, body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	var updated models.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &updated))
+	assert.Equal(t, j.ID, updated.ID)
+	assert.Equal(t, uint32(2), updated.Version)
+	assert.Equal(t, "hello-world", updated.Name)
+	assert.Equal(t, []string{"demo"}, updated.Tags)
+	assert.Equal(t, j.Tasks, updated.Tasks)
+
+	run := cltest.CreateJobRunViaWeb(t, app, updated)
+	assert.Equal(t, uint32(2), run.JobVersion)
+}
+
+func TestJobSpecsController_Update_Errors(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	j, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&j))
+	archived, _ := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&archived))
+	require.NoError(t, app.ArchiveJob(archived.ID))
+	sa, err := cltest.ServiceAgreementFromString(`{"initiators":[{"type":"web"}],"tasks":[{"type":"NoOp"}],"payment":"1"}`)
+	require.NoError(t, err)
+	require.NoError(t, app.Store.SaveServiceAgreement(&sa))
+
+	tests := []struct {
+		name   string
+		id     string
+		body   string
+		status int
+	}{
+		{"not found", "garbage", `{"name":"x"}`, 404},
+		{"archived", archived.ID, `{"name":"x"}`, 409},
+		{"service agreement", sa.JobSpecID, `{"name":"x"}`, 409},
+		{"unknown field", j.ID, `{"id":"x"}`, 400},
+		{"invalid name", j.ID, `{"name":"-bad"}`, 400},
+		{"invalid task", j.ID, `{"tasks":[{"type":"nonexistent"}]}`, 400},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Patch("/v2/specs/"+test.id, bytes.NewBufferString(test.body))
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, test.status)
+		})
+	}
+
+	found, err := app.Store.FindJob(j.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), found.Version)
+	found, err = app.Store.FindJob(sa.JobSpecID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), found.Version)
+}
+
+func TestJobSpecsController_Destroy_NotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Delete("/v2/specs/garbage")
+	defer cleanup()
+	assert.Equal(t, 404, resp.StatusCode, "Response should be not found")
+}
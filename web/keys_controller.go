@@ -1,6 +1,12 @@
 package web
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/services"
@@ -13,6 +19,50 @@ type KeysController struct {
 	App services.Application
 }
 
+// Index lists the accounts of the signer, which is the keystore unless a
+// remote signer is configured, with their balances and, for accounts used
+// for transactions, their next nonce.
+// Example:
+//  "<application>/keys"
+func (c *KeysController) Index(ctx *gin.Context) {
+	store := c.App.GetStore()
+	signing, err := store.SigningAccount()
+	if err != nil {
+		ctx.AbortWithError(500, err)
+		return
+	}
+
+	accts, err := store.Signer.SigningAccounts()
+	if err != nil {
+		ctx.AbortWithError(500, err)
+		return
+	}
+
+	keys := []presenters.Key{}
+	for _, account := range accts {
+		balance := getAccountBalanceFor(ctx, store, account)
+		if ctx.IsAborted() {
+			return
+		}
+		key := presenters.Key{
+			AccountBalance: balance,
+			Signing:        account.Address == signing.Address,
+		}
+		if ma := store.TxManager.GetAvailableAccount(account.Address); ma != nil {
+			nonce := ma.GetNonce()
+			key.Active = true
+			key.Nonce = &nonce
+		}
+		keys = append(keys, key)
+	}
+
+	if doc, err := jsonapi.Marshal(keys); err != nil {
+		ctx.AbortWithError(500, fmt.Errorf("failed to marshal keys using jsonapi: %+v", err))
+	} else {
+		ctx.Data(200, MediaType, doc)
+	}
+}
+
 // Create adds a new account
 // Example:
 //  "<application>/keys"
@@ -25,9 +75,113 @@ func (c *KeysController) Create(ctx *gin.Context) {
 		publicError(ctx, 401, err)
 	} else if account, err := c.App.GetStore().KeyStore.NewAccount(request.NewAccountPassword); err != nil {
 		ctx.AbortWithError(500, err)
-	} else if doc, err := jsonapi.Marshal(&presenters.NewAccount{&account}); err != nil {
+	} else if doc, err := c.registerAndMarshal(account); err != nil {
 		ctx.AbortWithError(500, err)
 	} else {
 		ctx.Data(201, MediaType, doc)
 	}
 }
+
+// Import adds an account from an encrypted keyfile.
+// Example:
+//  "<application>/keys/import"
+func (c *KeysController) Import(ctx *gin.Context) {
+	request := models.ImportKeyRequest{}
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		publicError(ctx, 422, err)
+	} else if account, err := c.App.GetStore().KeyStore.Import(request.Key, request.KeyPassword, request.CurrentPassword); err != nil {
+		publicError(ctx, 422, err)
+	} else if doc, err := c.registerAndMarshal(account); err != nil {
+		ctx.AbortWithError(500, err)
+	} else {
+		ctx.Data(201, MediaType, doc)
+	}
+}
+
+// Export returns the account's keyfile, encrypted with a new password, for
+// backup.
+// Example:
+//  "<application>/keys/:Address/export"
+func (c *KeysController) Export(ctx *gin.Context) {
+	request := models.ExportKeyRequest{}
+	if address, err := parseKeyAddress(ctx.Param("Address")); err != nil {
+		publicError(ctx, 422, err)
+	} else if err := ctx.ShouldBindJSON(&request); err != nil {
+		publicError(ctx, 422, err)
+	} else if _, err := c.App.GetStore().KeyStore.GetAccountByAddress(address); err != nil {
+		publicError(ctx, 404, err)
+	} else if keyJSON, err := c.App.GetStore().KeyStore.Export(address, request.CurrentPassword, request.NewPassword); err != nil {
+		publicError(ctx, keyErrorStatus(err), err)
+	} else {
+		ctx.Data(200, "application/json", keyJSON)
+	}
+}
+
+// Delete removes an account from the keystore, so it is no longer used for
+// transactions. The service agreement signing account cannot be deleted until
+// the node has rotated to another account, nor can an account that signed
+// service agreements that have not yet expired. An account with unconfirmed
+// transactions, whose gas can no longer be bumped once it is deleted, is only
+// deleted when forced.
+// Example:
+//  "<application>/keys/:Address"
+func (c *KeysController) Delete(ctx *gin.Context) {
+	request := models.DeleteKeyRequest{}
+	if address, err := parseKeyAddress(ctx.Param("Address")); err != nil {
+		publicError(ctx, 422, err)
+	} else if err := ctx.ShouldBindJSON(&request); err != nil {
+		publicError(ctx, 422, err)
+	} else if _, err := c.App.GetStore().KeyStore.GetAccountByAddress(address); err != nil {
+		publicError(ctx, 404, err)
+	} else if signing, err := c.App.GetStore().SigningAccount(); err == nil && signing.Address == address {
+		publicError(ctx, 422, errors.New("cannot delete the service agreement signing account, rotate to another account first"))
+	} else if sas, err := c.App.GetStore().UnexpiredServiceAgreementsSignedBy(address, c.App.GetStore().Clock.Now()); err != nil {
+		ctx.AbortWithError(500, err)
+	} else if len(sas) > 0 {
+		publicError(ctx, 422, fmt.Errorf("cannot delete an account that signed %d unexpired service agreements", len(sas)))
+	} else if txs, err := c.App.GetStore().TxFrom(address); err != nil {
+		ctx.AbortWithError(500, err)
+	} else if unconfirmed := countUnconfirmed(txs); unconfirmed > 0 && !request.Force {
+		publicError(ctx, 409, fmt.Errorf("account has %d unconfirmed transactions, wait for them to confirm or force the deletion", unconfirmed))
+	} else if account, err := c.App.GetStore().KeyStore.Delete(address, request.CurrentPassword); err != nil {
+		publicError(ctx, keyErrorStatus(err), err)
+	} else if doc, err := c.registerAndMarshal(account); err != nil {
+		ctx.AbortWithError(500, err)
+	} else {
+		ctx.Data(200, MediaType, doc)
+	}
+}
+
+// registerAndMarshal hands the signer's current accounts to the TxManager
+// so that changes take effect without a restart.
+func (c *KeysController) registerAndMarshal(account accounts.Account) ([]byte, error) {
+	if err := c.App.GetStore().RegisterSigningAccounts(); err != nil {
+		return nil, err
+	}
+	return jsonapi.Marshal(&presenters.NewAccount{&account})
+}
+
+func countUnconfirmed(txs []models.Tx) int {
+	count := 0
+	for _, tx := range txs {
+		if !tx.Confirmed {
+			count++
+		}
+	}
+	return count
+}
+
+// keyErrorStatus distinguishes a wrong password from other keystore errors.
+func keyErrorStatus(err error) int {
+	if err == keystore.ErrDecrypt {
+		return 401
+	}
+	return 422
+}
+
+func parseKeyAddress(address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, fmt.Errorf("%s is not a valid address", address)
+	}
+	return common.HexToAddress(address), nil
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/presenters"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestKeysController_CreateSuccess(t *testing.T) {
@@ -93,3 +95,110 @@ func TestKeysController_JSONBindingError(t *testing.T) {
 
 	ethMock.AllCalled()
 }
+
+func TestKeysController_Index(t *testing.T) {
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", "0x100")
+		ethMock.Register("eth_getBlockByNumber", models.BlockHeader{})
+	})
+	require.NoError(t, app.StartAndConnect())
+	ethMock.Register("eth_getBalance", "0x0100")
+	ethMock.Register("eth_call", "0x0100")
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/keys")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	keys := []presenters.Key{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &keys))
+	require.Len(t, keys, 1)
+	assert.Equal(t, cltest.GetAccountAddress(app.Store).Hex(), keys[0].Address)
+	assert.True(t, keys[0].Active)
+	assert.True(t, keys[0].Signing)
+	require.NotNil(t, keys[0].Nonce)
+	assert.Equal(t, uint64(0x100), *keys[0].Nonce)
+}
+
+func TestKeysController_ExportDeleteImport(t *testing.T) {
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+
+	ethMock := app.MockEthClient()
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", "0x100")
+		ethMock.Register("eth_getBlockByNumber", models.BlockHeader{})
+	})
+	extra, err := app.Store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	ethMock.Register("eth_getTransactionCount", "0x200")
+	require.NoError(t, app.StartAndConnect())
+	require.NotNil(t, app.Store.TxManager.GetAvailableAccount(extra.Address))
+	client := app.NewHTTPClient()
+	keyURL := "/v2/keys/" + extra.Address.Hex()
+
+	body, err := json.Marshal(models.ExportKeyRequest{CurrentPassword: "wrong", NewPassword: "kwyjibo"})
+	require.NoError(t, err)
+	resp, cleanup := client.Post(keyURL+"/export", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 401)
+
+	body, err = json.Marshal(models.ExportKeyRequest{CurrentPassword: cltest.Password, NewPassword: "kwyjibo"})
+	require.NoError(t, err)
+	resp, cleanup = client.Post(keyURL+"/export", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	keyJSON := cltest.ParseResponseBody(resp)
+
+	cltest.CreateTxAndAttempt(app.Store, extra.Address, 1)
+	body, err = json.Marshal(models.DeleteKeyRequest{CurrentPassword: cltest.Password})
+	require.NoError(t, err)
+	resp, cleanup = client.DeleteWithBody(keyURL, bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 409)
+	assert.Len(t, app.Store.KeyStore.Accounts(), 2)
+
+	body, err = json.Marshal(models.DeleteKeyRequest{CurrentPassword: cltest.Password, Force: true})
+	require.NoError(t, err)
+	resp, cleanup = client.DeleteWithBody(keyURL, bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	assert.Len(t, app.Store.KeyStore.Accounts(), 1)
+	assert.Nil(t, app.Store.TxManager.GetAvailableAccount(extra.Address))
+
+	ethMock.Register("eth_getTransactionCount", "0x300")
+	body, err = json.Marshal(models.ImportKeyRequest{
+		Key:             keyJSON,
+		KeyPassword:     "kwyjibo",
+		CurrentPassword: cltest.Password,
+	})
+	require.NoError(t, err)
+	resp, cleanup = client.Post("/v2/keys/import", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 201)
+	assert.Len(t, app.Store.KeyStore.Accounts(), 2)
+	ma := app.Store.TxManager.GetAvailableAccount(extra.Address)
+	require.NotNil(t, ma)
+	assert.Equal(t, uint64(0x300), ma.GetNonce())
+}
+
+func TestKeysController_Delete_InvalidAddress(t *testing.T) {
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	body := bytes.NewBufferString(`{"current_password":"password"}`)
+	resp, cleanup := client.DeleteWithBody("/v2/keys/notanaddress", body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+
+	body = bytes.NewBufferString(`{"current_password":"password"}`)
+	resp, cleanup = client.DeleteWithBody("/v2/keys/"+cltest.NewAddress().Hex(), body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 404)
+}
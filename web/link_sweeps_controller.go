@@ -0,0 +1,33 @@
+package web
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/services"
+)
+
+// LinkSweepsController lists the LINK swept from the oracle contract.
+type LinkSweepsController struct {
+	App services.Application
+}
+
+// Index returns the paginated history of LINK sweeps, most recent first.
+// Example:
+//  "<application>/link_sweeps"
+func (lsc *LinkSweepsController) Index(c *gin.Context) {
+	size, page, offset, err := ParsePaginatedRequest(c.Query("size"), c.Query("page"))
+	if err != nil {
+		publicError(c, 422, err)
+		return
+	}
+
+	sweeps, count, err := lsc.App.GetStore().LinkSweeps(offset, size)
+	if err != nil {
+		c.AbortWithError(500, fmt.Errorf("error getting paged LinkSweeps: %+v", err))
+	} else if buffer, err := NewPaginatedResponse(*c.Request.URL, size, page, count, sweeps); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, buffer)
+	}
+}
@@ -0,0 +1,48 @@
+package web_test
+
+import (
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkSweepsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	require.NoError(t, app.Start())
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+
+	for i := int64(1); i <= 3; i++ {
+		sweep := models.NewLinkSweep()
+		sweep.Amount = assets.NewLink(i)
+		sweep.BlockNumber = uint64(i)
+		sweep.CreatedAt = models.Time{Time: sweep.CreatedAt.AddDate(0, 0, int(i))}
+		require.NoError(t, store.SaveLinkSweep(&sweep))
+	}
+
+	resp, cleanup := client.Get("/v2/link_sweeps?size=2")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var links jsonapi.Links
+	var sweeps []models.LinkSweep
+	err := web.ParsePaginatedResponse(cltest.ParseResponseBody(resp), &sweeps, &links)
+	require.NoError(t, err)
+	assert.NotEmpty(t, links["next"].Href)
+	require.Len(t, sweeps, 2)
+	assert.Equal(t, uint64(3), sweeps[0].BlockNumber, "should list the most recent sweep first")
+	assert.Equal(t, assets.NewLink(3), sweeps[0].Amount)
+
+	resp, cleanup = client.Get("/v2/link_sweeps?size=Bogus")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+}
@@ -3,6 +3,7 @@ package web
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -144,6 +145,7 @@ func v2Routes(app services.Application, engine *gin.Engine) {
 
 	sa := ServiceAgreementsController{app}
 	v2.POST("/service_agreements", sa.Create)
+	v2.POST("/service_agreements/verify", sa.Verify)
 
 	authv2 := engine.Group("/v2", authRequired(app.GetStore()))
 	{
@@ -155,11 +157,25 @@ func v2Routes(app services.Application, engine *gin.Engine) {
 		authv2.GET("/specs", j.Index)
 		authv2.POST("/specs", j.Create)
 		authv2.GET("/specs/:SpecID", j.Show)
+		authv2.PATCH("/specs/:SpecID", j.Update)
+		authv2.DELETE("/specs/:SpecID", j.Destroy)
+		authv2.GET("/specs/:SpecID/export", j.Export)
+
+		jsv := JobSpecVersionsController{app}
+		authv2.GET("/specs/:SpecID/versions", jsv.Index)
+		authv2.GET("/specs/:SpecID/versions/:Version", jsv.Show)
+		authv2.GET("/specs/:SpecID/versions/:Version/diff", jsv.Diff)
 
 		authv2.GET("/runs", jr.Index)
 		authv2.POST("/specs/:SpecID/runs", jr.Create)
-		authv2.GET("/runs/:RunID", jr.Show)
+		authv2.GET("/runs/:RunID", staticSegment("RunID", "export", jr.Export, jr.Show))
+
+		ec := EarningsController{app}
+		authv2.GET("/earnings", ec.Index)
+		authv2.GET("/specs/:SpecID/earnings", ec.Show)
 
+		authv2.GET("/service_agreements", sa.Index)
+		authv2.POST("/service_agreements/coordinate", sa.Coordinate)
 		authv2.GET("/service_agreements/:SAID", sa.Show)
 
 		bt := BridgeTypesController{app}
@@ -172,17 +188,33 @@ func v2Routes(app services.Application, engine *gin.Engine) {
 		w := WithdrawalsController{app}
 		authv2.POST("/withdrawals", w.Create)
 
+		lsc := LinkSweepsController{app}
+		authv2.GET("/link_sweeps", lsc.Index)
+
 		ts := TransfersController{app}
 		authv2.POST("/transfers", ts.Create)
 
+		kc := KeysController{app}
+		authv2.GET("/keys", kc.Index)
 		if app.GetStore().Config.Dev() {
-			kc := KeysController{app}
 			authv2.POST("/keys", kc.Create)
 		}
+		authv2.POST("/keys/:Address", staticSegment("Address", "import", kc.Import, notFound))
+		authv2.POST("/keys/:Address/export", kc.Export)
+		authv2.DELETE("/keys/:Address", kc.Delete)
+
+		sac := SigningAccountController{app}
+		authv2.GET("/signing_account", sac.Show)
+		authv2.PATCH("/signing_account", sac.Update)
 
 		backup := BackupController{app}
 		authv2.GET("/backup", backup.Show)
 
+		compaction := CompactionController{app}
+		authv2.GET("/compaction", compaction.Show)
+		authv2.POST("/compaction", compaction.Create)
+		authv2.DELETE("/compaction", compaction.Destroy)
+
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
 
@@ -192,9 +224,41 @@ func v2Routes(app services.Application, engine *gin.Engine) {
 		bdc := BulkDeletesController{app}
 		authv2.POST("/bulk_delete_runs", bdc.Create)
 		authv2.GET("/bulk_delete_runs/:taskID", bdc.Show)
+		authv2.DELETE("/bulk_delete_runs/:taskID", bdc.Destroy)
+
+		rrc := RunRetentionController{app}
+		authv2.GET("/run_retention", rrc.Show)
+
+		wh := WebhooksController{app}
+		authv2.GET("/webhooks", wh.Index)
+		authv2.POST("/webhooks", wh.Create)
+		authv2.GET("/webhooks/:WebhookID", wh.Show)
+		authv2.DELETE("/webhooks/:WebhookID", wh.Destroy)
+		authv2.GET("/webhooks/:WebhookID/deliveries", wh.Deliveries)
+
+		sc := StreamController{app}
+		authv2.GET("/stream", sc.Show)
 	}
 }
 
+// staticSegment routes requests whose wildcard param equals segment to
+// static, and all others to wildcard. The router panics when a static path
+// is registered next to a wildcard one, such as /runs/export next to
+// /runs/:RunID.
+func staticSegment(param, segment string, static, wildcard gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Param(param) == segment {
+			static(c)
+		} else {
+			wildcard(c)
+		}
+	}
+}
+
+func notFound(c *gin.Context) {
+	c.AbortWithError(404, errors.New("Not found"))
+}
+
 func guiAssetRoutes(box packr.Box, engine *gin.Engine) {
 	boxList := box.List()
 
@@ -0,0 +1,66 @@
+package web
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// RunRetentionController reports on the node's run retention policy.
+type RunRetentionController struct {
+	App services.Application
+}
+
+// Show returns the run retention policy along with the runs it would delete
+// if the reaper ran now, without deleting them. The maxAge, maxCount and
+// statuses query parameters preview the runs a different policy would
+// delete.
+// Example:
+//  "<application>/run_retention?maxAge=72h&statuses=errored"
+func (rrc *RunRetentionController) Show(c *gin.Context) {
+	store := rrc.App.GetStore()
+	policy, err := parseRunRetentionPolicy(c, services.NewRunRetentionPolicy(store.Config))
+	if err != nil {
+		publicError(c, 422, err)
+	} else if report, err := services.RunRetentionDryRun(store.ORM, policy, store.Clock.Now()); err != nil {
+		c.AbortWithError(500, fmt.Errorf("error finding runs to delete: %+v", err))
+	} else if doc, err := jsonapi.Marshal(report); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// parseRunRetentionPolicy overrides the policy with the request's query
+// parameters.
+func parseRunRetentionPolicy(c *gin.Context, policy models.RunRetentionPolicy) (models.RunRetentionPolicy, error) {
+	if value := c.Query("maxAge"); value != "" {
+		maxAge, err := time.ParseDuration(value)
+		if err != nil {
+			return policy, fmt.Errorf("invalid maxAge %s: %v", value, err)
+		} else if maxAge < 0 {
+			return policy, fmt.Errorf("invalid maxAge %s: must not be negative", value)
+		}
+		policy.MaxAge = maxAge
+	}
+	if value := c.Query("maxCount"); value != "" {
+		maxCount, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return policy, fmt.Errorf("invalid maxCount %s: %v", value, err)
+		}
+		policy.MaxCount = maxCount
+	}
+	if value := c.Query("statuses"); value != "" {
+		statuses, err := models.ParseRunRetentionStatuses(value)
+		if err != nil {
+			return policy, err
+		}
+		policy.Statuses = statuses
+	}
+	return policy, nil
+}
@@ -0,0 +1,61 @@
+package web_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunRetentionController_Show(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	app.Store.Config.Set("RUN_RETENTION_MAX_AGE", "24h")
+	client := app.NewHTTPClient()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.SaveJob(&job))
+	old := job.NewRun(initr)
+	old.Status = models.RunStatusCompleted
+	old.UpdatedAt = time.Now().Add(-48 * time.Hour)
+	require.NoError(t, app.Store.ORM.DB.Save(&old))
+	recent := job.NewRun(initr)
+	recent.Status = models.RunStatusCompleted
+	require.NoError(t, app.Store.SaveJobRun(&recent))
+
+	resp, cleanup := client.Get("/v2/run_retention")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var report presenters.RunRetention
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &report))
+	assert.True(t, report.Enabled)
+	assert.Equal(t, 24*time.Hour, report.MaxAge)
+	assert.Equal(t, 1, report.RunCount)
+	require.Len(t, report.Runs, 1)
+	assert.Equal(t, old.ID, report.Runs[0].RunID)
+
+	resp, cleanup = client.Get("/v2/run_retention?maxAge=1h&statuses=errored")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &report))
+	assert.Equal(t, time.Hour, report.MaxAge)
+	assert.Equal(t, 0, report.RunCount)
+
+	resp, cleanup = client.Get("/v2/run_retention?statuses=in_progress")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+
+	resp, cleanup = client.Get("/v2/run_retention?maxCount=-1")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+
+	runs, err := app.Store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	assert.Len(t, runs, 2)
+}
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
@@ -18,6 +19,33 @@ type ServiceAgreementsController struct {
 	App services.Application
 }
 
+// Index returns paginated service agreements, most recent first.
+// Example:
+//  "<application>/service_agreements"
+func (sac *ServiceAgreementsController) Index(c *gin.Context) {
+	size, page, offset, err := ParsePaginatedRequest(c.Query("size"), c.Query("page"))
+	if err != nil {
+		publicError(c, 422, err)
+		return
+	}
+
+	sas, count, err := sac.App.GetStore().ServiceAgreements(offset, size)
+	if err != nil {
+		_ = c.AbortWithError(500, fmt.Errorf("error getting paged ServiceAgreements: %+v", err))
+		return
+	}
+
+	psas := make([]presenters.ServiceAgreement, len(sas))
+	for i, sa := range sas {
+		psas[i] = presenters.ServiceAgreement{ServiceAgreement: sa}
+	}
+	if buffer, err := NewPaginatedResponse(*c.Request.URL, size, page, count, psas); err != nil {
+		_ = c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, buffer)
+	}
+}
+
 // Create builds and saves a new service agreement record.
 func (sac *ServiceAgreementsController) Create(c *gin.Context) {
 	if !sac.App.GetStore().Config.Dev() {
@@ -33,7 +61,12 @@ func (sac *ServiceAgreementsController) Create(c *gin.Context) {
 
 	sa, err := sac.App.GetStore().FindServiceAgreement(us.ID.String())
 	if err == orm.ErrorNotFound {
-		sa, err = models.BuildServiceAgreement(us, sac.App.GetStore().KeyStore)
+		signer, err := sac.App.GetStore().ServiceAgreementSigner()
+		if err != nil {
+			_ = c.AbortWithError(500, err)
+			return
+		}
+		sa, err = models.BuildServiceAgreement(us, signer)
 		if err != nil {
 			publicError(c, 422, err)
 			return
@@ -55,6 +88,43 @@ func (sac *ServiceAgreementsController) Create(c *gin.Context) {
 	}
 }
 
+// Coordinate submits the agreement in the request to each of the listed
+// nodes, returning their combined signatures in the form expected by the
+// Coordinator contract.
+// Example:
+//  "<application>/service_agreements/coordinate"
+func (sac *ServiceAgreementsController) Coordinate(c *gin.Context) {
+	request := models.CoordinateServiceAgreementRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		publicError(c, 422, err)
+	} else if csa, err := services.NewServiceAgreementCollector().Collect(request.Agreement, request.Nodes); err != nil {
+		publicError(c, 422, err)
+	} else if buffer, err := NewJSONAPIResponse(&csa); err != nil {
+		_ = c.AbortWithError(500, fmt.Errorf("failed to marshal document: %+v", err))
+	} else {
+		c.Data(200, MediaType, buffer)
+	}
+}
+
+// Verify recovers the signer of the signature in the request and checks that
+// it is one of the agreement's oracles.
+// Example:
+//  "<application>/service_agreements/verify"
+func (sac *ServiceAgreementsController) Verify(c *gin.Context) {
+	request := models.VerifyServiceAgreementRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		publicError(c, 422, err)
+	} else if us, err := models.NewUnsignedServiceAgreementFromRequest(bytes.NewReader(request.Agreement)); err != nil {
+		publicError(c, 422, err)
+	} else if sav, err := models.VerifyServiceAgreement(us, request.Signature, request.Address); err != nil {
+		publicError(c, 422, err)
+	} else if buffer, err := NewJSONAPIResponse(&sav); err != nil {
+		_ = c.AbortWithError(500, fmt.Errorf("failed to marshal document: %+v", err))
+	} else {
+		c.Data(200, MediaType, buffer)
+	}
+}
+
 // Show returns the details of a ServiceAgreement.
 // Example:
 //  "<application>/service_agreements/:SAID"
@@ -2,13 +2,21 @@ package web_test
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/smartcontractkit/chainlink/web"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestServiceAgreementsController_Create(t *testing.T) {
@@ -50,6 +58,7 @@ func TestServiceAgreementsController_Create(t *testing.T) {
 				createdSA := cltest.FindServiceAgreement(app.Store, responseSA.ID)
 				assert.NotEqual(t, "", createdSA.ID)
 				assert.NotEqual(t, "", createdSA.Signature.String())
+				assert.Equal(t, cltest.GetAccountAddress(app.Store), createdSA.SigningAddress)
 				assert.Equal(t, time.Unix(1571523439, 0).UTC(), createdSA.Encumbrance.EndAt.Time)
 
 				var jobids []string
@@ -63,6 +72,89 @@ func TestServiceAgreementsController_Create(t *testing.T) {
 	}
 }
 
+func TestServiceAgreementsController_Coordinate(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+	agreement := cltest.EasyJSONFromFixture("../internal/fixtures/web/hello_world_agreement.json")
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"invalid JSON", "{"},
+		{"no nodes", fmt.Sprintf(`{"agreement":%s,"nodes":[]}`, agreement.String())},
+		{"unreachable node", fmt.Sprintf(`{"agreement":%s,"nodes":["http://localhost:1"]}`, agreement.String())},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/service_agreements/coordinate", bytes.NewBufferString(test.input))
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, 422)
+		})
+	}
+}
+
+func TestServiceAgreementsController_Verify(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	oracle := crypto.PubkeyToAddress(key.PublicKey)
+	agreement := cltest.EasyJSONFromFixture("../internal/fixtures/web/hello_world_agreement.json").
+		Add("oracles", []string{oracle.Hex()})
+	us, err := models.NewUnsignedServiceAgreementFromRequest(bytes.NewBufferString(agreement.String()))
+	require.NoError(t, err)
+	hash, err := utils.Keccak256(us.ID.Bytes())
+	require.NoError(t, err)
+	output, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	signature := models.BytesToSignature(output)
+
+	tests := []struct {
+		name      string
+		input     string
+		wantCode  int
+		wantValid bool
+	}{
+		{"valid signature", fmt.Sprintf(`{"agreement":%s,"signature":"%s"}`, agreement.String(), signature.Hex()), 200, true},
+		{"expected another address", fmt.Sprintf(`{"agreement":%s,"signature":"%s","address":"%s"}`,
+			agreement.String(), signature.Hex(), cltest.NewAddress().Hex()), 200, false},
+		{"unrecoverable signature", fmt.Sprintf(`{"agreement":%s,"signature":"0x00"}`, agreement.String()), 422, false},
+		{"invalid JSON", "{", 422, false},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := http.Post(
+				app.Server.URL+"/v2/service_agreements/verify",
+				"application/json",
+				bytes.NewBufferString(test.input),
+			)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			cltest.AssertServerResponse(t, resp, test.wantCode)
+			if test.wantCode == 200 {
+				var sav models.ServiceAgreementVerification
+				require.NoError(t, cltest.ParseJSONAPIResponse(resp, &sav))
+				assert.Equal(t, us.ID, sav.ID)
+				assert.Equal(t, oracle, sav.Signer)
+				assert.True(t, sav.Oracle)
+				assert.Equal(t, test.wantValid, sav.Valid)
+			}
+		})
+	}
+}
+
 func TestServiceAgreementsController_Create_isIdempotent(t *testing.T) {
 	t.Parallel()
 
@@ -93,6 +185,40 @@ func TestServiceAgreementsController_Create_isIdempotent(t *testing.T) {
 	eth.EventuallyAllCalled(t)
 }
 
+func TestServiceAgreementsController_Index(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	var created []models.ServiceAgreement
+	for i := 1; i <= 3; i++ {
+		sa, err := cltest.ServiceAgreementFromString(fmt.Sprintf(`{"initiators":[{"type":"web"}],"tasks":[{"type":"NoOp"}],"payment":"%d"}`, i))
+		require.NoError(t, err)
+		sa.CreatedAt = models.Time{Time: sa.CreatedAt.AddDate(0, 0, i)}
+		require.NoError(t, app.Store.SaveServiceAgreement(&sa))
+		created = append(created, sa)
+	}
+
+	resp, cleanup := client.Get("/v2/service_agreements?size=2")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var links jsonapi.Links
+	var sas []presenters.ServiceAgreement
+	err := web.ParsePaginatedResponse(cltest.ParseResponseBody(resp), &sas, &links)
+	require.NoError(t, err)
+	assert.NotEmpty(t, links["next"].Href)
+	require.Len(t, sas, 2)
+	assert.Equal(t, created[2].ID, sas[0].ID, "should list the most recent agreement first")
+	assert.Equal(t, created[2].Encumbrance.Payment, sas[0].Encumbrance.Payment)
+	assert.Equal(t, created[2].SigningAddress, sas[0].SigningAddress)
+
+	resp, cleanup = client.Get("/v2/service_agreements?size=Bogus")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+}
+
 func TestServiceAgreementsController_Show(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication()
@@ -112,5 +238,13 @@ func TestServiceAgreementsController_Show(t *testing.T) {
 	assert.NoError(t, err)
 	normalizedInput := cltest.NormalizedJSON(input)
 	saBody := cltest.JSONFromString(string(b)).Get("data").Get("attributes")
-	assert.Equal(t, normalizedInput, saBody.String())
+	assert.Equal(t, sa.SigningAddress.Hex(), saBody.Get("signingAddress").String())
+	requestBody, err := models.ParseJSON([]byte(saBody.Raw))
+	assert.NoError(t, err)
+	assert.Equal(t, string(sa.Status(time.Now())), saBody.Get("status").String())
+	for _, key := range []string{"signingAddress", "createdAt", "status"} {
+		requestBody, err = requestBody.Delete(key)
+		assert.NoError(t, err)
+	}
+	assert.JSONEq(t, normalizedInput, requestBody.String())
 }
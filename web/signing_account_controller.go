@@ -0,0 +1,45 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+)
+
+// SigningAccountController manages the account used to sign service
+// agreements.
+type SigningAccountController struct {
+	App services.Application
+}
+
+// Show returns the address new service agreements are signed with.
+// Example:
+//  "<application>/signing_account"
+func (sac *SigningAccountController) Show(c *gin.Context) {
+	if account, err := sac.App.GetStore().SigningAccount(); err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(&presenters.SigningAccount{Address: account.Address}); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// Update rotates the signing key to another account held by the signer.
+// Existing service agreements keep the address they were signed with.
+// Example:
+//  "<application>/signing_account"
+func (sac *SigningAccountController) Update(c *gin.Context) {
+	request := models.RotateSigningAccountRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		publicError(c, 422, err)
+	} else if account, err := sac.App.GetStore().RotateSigningAccount(request.Address); err != nil {
+		publicError(c, 422, err)
+	} else if doc, err := jsonapi.Marshal(&presenters.SigningAccount{Address: account.Address}); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
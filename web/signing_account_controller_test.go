@@ -0,0 +1,83 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningAccountController_Show(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/signing_account")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	account := presenters.SigningAccount{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &account))
+	assert.Equal(t, cltest.GetAccountAddress(app.Store), account.Address)
+}
+
+func TestSigningAccountController_Update(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	original := cltest.GetAccountAddress(app.Store)
+	extra, err := app.Store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	body, err := json.Marshal(models.RotateSigningAccountRequest{Address: cltest.NewAddress()})
+	require.NoError(t, err)
+	resp, cleanup := client.Patch("/v2/signing_account", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+
+	body, err = json.Marshal(models.RotateSigningAccountRequest{Address: extra.Address})
+	require.NoError(t, err)
+	resp, cleanup = client.Patch("/v2/signing_account", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	account := presenters.SigningAccount{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &account))
+	assert.Equal(t, extra.Address, account.Address)
+	signing, err := app.Store.SigningAccount()
+	require.NoError(t, err)
+	assert.Equal(t, extra.Address, signing.Address)
+
+	deleteBody, err := json.Marshal(models.DeleteKeyRequest{CurrentPassword: cltest.Password})
+	require.NoError(t, err)
+	resp, cleanup = client.DeleteWithBody("/v2/keys/"+extra.Address.Hex(), bytes.NewBuffer(deleteBody))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+
+	sa := models.ServiceAgreement{
+		ID:             cltest.NewHash().Hex(),
+		SigningAddress: original,
+		Encumbrance:    models.Encumbrance{EndAt: models.Time{Time: time.Now().Add(time.Hour)}},
+		JobSpec:        cltest.NewJob(),
+	}
+	require.NoError(t, app.Store.SaveServiceAgreement(&sa))
+	resp, cleanup = client.DeleteWithBody("/v2/keys/"+original.Hex(), bytes.NewBuffer(deleteBody))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+
+	sa.Encumbrance.EndAt = models.Time{Time: time.Now().Add(-time.Hour)}
+	require.NoError(t, app.Store.SaveServiceAgreement(&sa))
+	resp, cleanup = client.DeleteWithBody("/v2/keys/"+original.Hex(), bytes.NewBuffer(deleteBody))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+}
@@ -0,0 +1,46 @@
+package web
+
+import (
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store"
+)
+
+// StreamController pushes changes to job runs, heads and transaction
+// attempts to clients as server-sent events.
+type StreamController struct {
+	App services.Application
+}
+
+// Show streams node events until the client disconnects. Events can be
+// restricted with comma separated jobId and type query parameters. Heads and
+// transaction attempts are not tied to a job, and are left out when
+// filtering by jobId.
+// Example:
+//  "<application>/stream?jobId=:JobID&type=jobRun,txAttempt"
+func (sc *StreamController) Show(c *gin.Context) {
+	filter, err := store.NewEventFilter(c.Query("jobId"), c.Query("type"))
+	if err != nil {
+		publicError(c, 422, err)
+		return
+	}
+
+	sub := sc.App.GetStore().Events.Subscribe(filter)
+	defer sub.Unsubscribe()
+
+	closed := c.Request.Context().Done()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return false
+			}
+			c.SSEvent(string(event.Type), event)
+			return true
+		case <-closed:
+			return false
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package web_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamController_Show(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", "0x100")
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	run := job.NewRun(initiator)
+	otherJob, otherInitiator := cltest.NewJobWithWebInitiator()
+	otherRun := otherJob.NewRun(otherInitiator)
+
+	// Events are only delivered once the request has subscribed, so keep
+	// publishing until the stream is read.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+				app.Store.Events.PublishHead(models.BlockHeader{})
+				app.Store.Events.PublishJobRun(otherRun)
+				app.Store.Events.PublishJobRun(run)
+			}
+		}
+	}()
+
+	resp, cleanup := client.Get("/v2/stream?type=jobRun&jobId=" + job.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/event-stream")
+
+	reader := bufio.NewReader(resp.Body)
+	var lines []string
+	for len(lines) < 2 {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	assert.Equal(t, "event:jobRun", lines[0])
+	assert.Contains(t, lines[1], run.ID)
+	assert.NotContains(t, lines[1], otherRun.ID)
+}
+
+func TestStreamController_Show_InvalidType(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", "0x100")
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/stream?type=bogus")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 422)
+}
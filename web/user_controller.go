@@ -73,7 +73,11 @@ func (c *UserController) UpdatePassword(ctx *gin.Context) {
 //  "<application>/user/balances"
 func (c *UserController) AccountBalances(ctx *gin.Context) {
 	store := c.App.GetStore()
-	accounts := store.KeyStore.Accounts()
+	accounts, err := store.Signer.SigningAccounts()
+	if err != nil {
+		ctx.AbortWithError(500, err)
+		return
+	}
 	balances := []presenters.AccountBalance{}
 	for _, a := range accounts {
 		pa := getAccountBalanceFor(ctx, store, a)
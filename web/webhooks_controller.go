@@ -0,0 +1,123 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/orm"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+)
+
+// WebhooksController manages the webhooks notified of job run lifecycle
+// events.
+type WebhooksController struct {
+	App services.Application
+}
+
+// Index lists webhooks, one page at a time.
+// Example:
+//  "<application>/webhooks"
+func (wc *WebhooksController) Index(c *gin.Context) {
+	size, page, offset, err := ParsePaginatedRequest(c.Query("size"), c.Query("page"))
+	if err != nil {
+		publicError(c, 422, err)
+		return
+	}
+
+	webhooks, count, err := wc.App.GetStore().Webhooks(offset, size)
+	if err != nil {
+		c.AbortWithError(500, fmt.Errorf("error getting webhooks: %+v", err))
+		return
+	}
+	pwh := make([]presenters.Webhook, len(webhooks))
+	for i, wh := range webhooks {
+		pwh[i] = presenters.Webhook{Webhook: wh}
+	}
+	buffer, err := NewPaginatedResponse(*c.Request.URL, size, page, count, pwh)
+	if err != nil {
+		c.AbortWithError(500, fmt.Errorf("failed to marshal document: %+v", err))
+	} else {
+		c.Data(200, MediaType, buffer)
+	}
+}
+
+// Create registers a new webhook. The response is the only time the
+// webhook's secret is returned.
+// Example:
+//  "<application>/webhooks"
+func (wc *WebhooksController) Create(c *gin.Context) {
+	request := models.WebhookRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		publicError(c, 422, err)
+	} else if webhook, err := models.NewWebhookFromRequest(request); err != nil {
+		publicError(c, 422, err)
+	} else if err := wc.App.GetStore().SaveWebhook(&webhook); err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(webhook); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(201, MediaType, doc)
+	}
+}
+
+// Show returns the details of a webhook.
+// Example:
+//  "<application>/webhooks/:WebhookID"
+func (wc *WebhooksController) Show(c *gin.Context) {
+	id := c.Param("WebhookID")
+	if webhook, err := wc.App.GetStore().FindWebhook(id); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("Webhook not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(presenters.Webhook{Webhook: webhook}); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// Destroy removes a webhook and its delivery history.
+// Example:
+//  "<application>/webhooks/:WebhookID"
+func (wc *WebhooksController) Destroy(c *gin.Context) {
+	id := c.Param("WebhookID")
+	if webhook, err := wc.App.GetStore().FindWebhook(id); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("Webhook not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if err := wc.App.GetStore().DeleteWebhook(&webhook); err != nil {
+		c.AbortWithError(500, err)
+	} else if doc, err := jsonapi.Marshal(presenters.Webhook{Webhook: webhook}); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.Data(200, MediaType, doc)
+	}
+}
+
+// Deliveries lists the delivery history of a webhook, most recent first.
+// Example:
+//  "<application>/webhooks/:WebhookID/deliveries"
+func (wc *WebhooksController) Deliveries(c *gin.Context) {
+	size, page, offset, err := ParsePaginatedRequest(c.Query("size"), c.Query("page"))
+	if err != nil {
+		publicError(c, 422, err)
+		return
+	}
+
+	id := c.Param("WebhookID")
+	if _, err := wc.App.GetStore().FindWebhook(id); err == orm.ErrorNotFound {
+		publicError(c, 404, errors.New("Webhook not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if deliveries, count, err := wc.App.GetStore().WebhookDeliveriesFor(id, offset, size); err != nil {
+		c.AbortWithError(500, fmt.Errorf("error getting webhook deliveries: %+v", err))
+	} else if buffer, err := NewPaginatedResponse(*c.Request.URL, size, page, count, deliveries); err != nil {
+		c.AbortWithError(500, fmt.Errorf("failed to marshal document: %+v", err))
+	} else {
+		c.Data(200, MediaType, buffer)
+	}
+}
@@ -0,0 +1,164 @@
+package web_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooksController_Create(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", "0x100")
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	body := `{"url":"https://example.com/hook","secret":"shh","events":["run.errored"]}`
+	resp, cleanup := client.Post("/v2/webhooks", bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 201)
+
+	var webhook models.Webhook
+	require.NoError(t, cltest.ParseJSONAPIResponse(resp, &webhook))
+	assert.Equal(t, "shh", webhook.Secret)
+
+	saved, err := app.Store.FindWebhook(webhook.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/hook", saved.URL.String())
+	assert.Equal(t, []models.WebhookEvent{models.WebhookEventRunErrored}, saved.Events)
+}
+
+func TestWebhooksController_Create_Invalid(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", "0x100")
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"unknown event", `{"url":"https://example.com/hook","events":["run.started"]}`},
+		{"relative url", `{"url":"/hook"}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/webhooks", bytes.NewBufferString(test.body))
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, 422)
+		})
+	}
+}
+
+func TestWebhooksController_Index_HidesSecret(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", "0x100")
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	webhook, err := models.NewWebhookFromRequest(models.WebhookRequest{
+		URL: cltest.WebURL("https://example.com/hook"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, app.Store.SaveWebhook(&webhook))
+
+	resp, cleanup := client.Get("/v2/webhooks")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var links jsonapi.Links
+	var webhooks []models.Webhook
+	err = web.ParsePaginatedResponse(cltest.ParseResponseBody(resp), &webhooks, &links)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, webhook.ID, webhooks[0].ID)
+	assert.Empty(t, webhooks[0].Secret)
+}
+
+func TestWebhooksController_ShowAndDestroy(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", "0x100")
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	webhook, err := models.NewWebhookFromRequest(models.WebhookRequest{
+		URL: cltest.WebURL("https://example.com/hook"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, app.Store.SaveWebhook(&webhook))
+
+	resp, cleanup := client.Get("/v2/webhooks/" + webhook.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	resp, cleanup = client.Delete("/v2/webhooks/" + webhook.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	resp, cleanup = client.Get("/v2/webhooks/" + webhook.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 404)
+}
+
+func TestWebhooksController_Deliveries(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", "0x100")
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	webhook, err := models.NewWebhookFromRequest(models.WebhookRequest{
+		URL: cltest.WebURL("http://localhost:1/hook"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, app.Store.SaveWebhook(&webhook))
+
+	job, initiator := cltest.NewJobWithWebInitiator()
+	run := job.NewRun(initiator)
+	run.Status = models.RunStatusCompleted
+	delivery, err := models.NewWebhookDelivery(webhook, models.WebhookEventRunCompleted, run)
+	require.NoError(t, err)
+	delivery.Status = models.WebhookDeliveryStatusFailed
+	require.NoError(t, app.Store.SaveWebhookDelivery(&delivery))
+
+	resp, cleanup := client.Get("/v2/webhooks/" + webhook.ID + "/deliveries")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var links jsonapi.Links
+	var deliveries []models.WebhookDelivery
+	err = web.ParsePaginatedResponse(cltest.ParseResponseBody(resp), &deliveries, &links)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, delivery.ID, deliveries[0].ID)
+	assert.Equal(t, models.WebhookDeliveryStatusFailed, deliveries[0].Status)
+
+	resp, cleanup = client.Get("/v2/webhooks/bogus/deliveries")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, 404)
+}